@@ -1,14 +1,17 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/braccet/bracket/internal/api"
 	"github.com/braccet/bracket/internal/client"
 	"github.com/braccet/bracket/internal/config"
 	"github.com/braccet/bracket/internal/repository"
+	"github.com/braccet/bracket/internal/service"
 )
 
 func getEnv(key, defaultVal string) string {
@@ -30,6 +33,10 @@ func main() {
 	// Create repositories
 	repo := repository.NewMatchRepository(db)
 	setRepo := repository.NewSetRepository(db)
+	slotRepo := repository.NewSlotRepository(db)
+	stationRepo := repository.NewStationRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	eventRepo := repository.NewEventRepository(db)
 
 	// Create clients for cross-service communication
 	tournamentServiceURL := getEnv("TOURNAMENT_SERVICE_URL", "http://localhost:8081")
@@ -37,8 +44,16 @@ func main() {
 	tournamentClient := client.NewTournamentClient(tournamentServiceURL)
 	communityClient := client.NewCommunityClient(communityServiceURL)
 
+	// Forfeit no-shows once a called match's check-in window closes
+	matchSvc := service.NewMatchService(repo, setRepo, slotRepo, stationRepo, eventRepo, tournamentClient, communityClient)
+	go service.RunCheckInTimer(context.Background(), matchSvc, 15*time.Second)
+
+	// Let reported results stand once their confirm window closes
+	reportSvc := service.NewReportService(repo, reportRepo, matchSvc, tournamentClient)
+	go service.RunReportTimer(context.Background(), reportSvc, 15*time.Second)
+
 	// Create router
-	router := api.NewRouter(repo, setRepo, tournamentClient, communityClient)
+	router := api.NewRouter(repo, setRepo, slotRepo, stationRepo, reportRepo, eventRepo, tournamentClient, communityClient)
 
 	// Get port from environment
 	port := os.Getenv("SERVICE_PORT")
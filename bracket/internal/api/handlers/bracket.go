@@ -1,9 +1,13 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
@@ -15,32 +19,102 @@ import (
 type BracketHandler struct {
 	bracketSvc service.BracketService
 	matchSvc   service.MatchService
+	heatSvc    service.HeatService
 	repo       repository.MatchRepository
 	setRepo    repository.SetRepository
+	slotRepo   repository.SlotRepository
 }
 
-func NewBracketHandler(bracketSvc service.BracketService, matchSvc service.MatchService, repo repository.MatchRepository, setRepo repository.SetRepository) *BracketHandler {
+func NewBracketHandler(
+	bracketSvc service.BracketService,
+	matchSvc service.MatchService,
+	heatSvc service.HeatService,
+	repo repository.MatchRepository,
+	setRepo repository.SetRepository,
+	slotRepo repository.SlotRepository,
+) *BracketHandler {
 	return &BracketHandler{
 		bracketSvc: bracketSvc,
 		matchSvc:   matchSvc,
+		heatSvc:    heatSvc,
 		repo:       repo,
 		setRepo:    setRepo,
+		slotRepo:   slotRepo,
 	}
 }
 
 type GenerateBracketRequest struct {
 	TournamentID uint64               `json:"tournament_id"`
-	Format       string               `json:"format"` // "single_elimination" or "double_elimination"
+	Format       string               `json:"format"` // "single_elimination", "double_elimination", "round_robin", "swiss", "multi_stage" or "free_for_all"
 	Participants []domain.Participant `json:"participants"`
 }
 
+type LateEntryRequest struct {
+	TournamentID    uint64 `json:"tournament_id"`
+	ParticipantID   uint64 `json:"participant_id"`
+	ParticipantName string `json:"participant_name"`
+	Seed            int    `json:"seed"`
+}
+
+type SubstituteRequest struct {
+	TournamentID   uint64 `json:"tournament_id"`
+	ParticipantID  uint64 `json:"participant_id"`
+	SubstituteID   uint64 `json:"substitute_id"`
+	SubstituteName string `json:"substitute_name"`
+	SubstituteSeed int    `json:"substitute_seed"`
+}
+
 type BracketResponse struct {
 	TournamentID uint64           `json:"tournament_id"`
+	Stage        int              `json:"stage"`
 	TotalRounds  int              `json:"total_rounds"`
 	CurrentRound int              `json:"current_round"`
 	IsComplete   bool             `json:"is_complete"`
 	ChampionID   *uint64          `json:"champion_id,omitempty"`
 	Matches      []*MatchResponse `json:"matches"`
+
+	SeedingConflicts []SeedingConflictResponse `json:"seeding_conflicts,omitempty"`
+}
+
+// SeedingConflictResponse is a pair of participants sharing a tag who meet earlier
+// than the tournament's separation round.
+type SeedingConflictResponse struct {
+	Tag            string `json:"tag"`
+	Participant1ID uint64 `json:"participant1_id"`
+	Participant2ID uint64 `json:"participant2_id"`
+	Round          int    `json:"round"`
+}
+
+// ScheduleResponse is a tournament's timeline: its scheduled matches in start order.
+type ScheduleResponse struct {
+	TournamentID uint64           `json:"tournament_id"`
+	Matches      []*MatchResponse `json:"matches"`
+}
+
+type StandingsResponse struct {
+	TournamentID uint64              `json:"tournament_id"`
+	Stage        int                 `json:"stage"`
+	Tiebreakers  []string            `json:"tiebreakers"`
+	Standings    []*StandingResponse `json:"standings"`
+}
+
+type StandingResponse struct {
+	Rank            int    `json:"rank"`
+	Pool            int    `json:"pool,omitempty"`
+	ParticipantID   uint64 `json:"participant_id"`
+	ParticipantName string `json:"participant_name"`
+	Seed            int    `json:"seed"`
+	Played          int    `json:"played"`
+	Wins            int    `json:"wins"`
+	Draws           int    `json:"draws"`
+	Losses          int    `json:"losses"`
+	Byes            int    `json:"byes"`
+	SetsWon         int    `json:"sets_won"`
+	SetsLost        int    `json:"sets_lost"`
+	SetDifferential int    `json:"set_differential"`
+
+	Buchholz              float64 `json:"buchholz"`
+	OpponentWinPercentage float64 `json:"opponent_win_percentage"`
 }
 
 type SetResponse struct {
@@ -49,8 +123,33 @@ type SetResponse struct {
 	Participant2Score int `json:"participant2_score"`
 }
 
+// MatchReportResponse is a result reported by one of a match's participants.
+type MatchReportResponse struct {
+	ParticipantID   uint64        `json:"participant_id"`
+	Sets            []SetResponse `json:"sets,omitempty"`
+	Outcome         string        `json:"outcome,omitempty"`
+	ConfirmDeadline string        `json:"confirm_deadline"`
+}
+
+type SlotResponse struct {
+	SlotNumber      int     `json:"slot_number"`
+	ParticipantID   *uint64 `json:"participant_id,omitempty"`
+	ParticipantName *string `json:"participant_name,omitempty"`
+	Seed            *int    `json:"seed,omitempty"`
+	Placement       *int    `json:"placement,omitempty"`
+}
+
+// ProjectedParticipantResponse is who is expected in a match slot if seeds hold.
+type ProjectedParticipantResponse struct {
+	ParticipantID   uint64 `json:"participant_id"`
+	ParticipantName string `json:"participant_name"`
+	Seed            int    `json:"seed"`
+}
+
 type MatchResponse struct {
 	ID               uint64        `json:"id"`
+	Stage            int           `json:"stage"`
+	Pool             int           `json:"pool,omitempty"`
 	Round            int           `json:"round"`
 	Position         int           `json:"position"`
 	BracketType      string        `json:"bracket_type"`
@@ -65,8 +164,39 @@ type MatchResponse struct {
 	Participant2Sets int           `json:"participant2_sets"`
 	WinnerID         *uint64       `json:"winner_id,omitempty"`
 	ForfeitWinnerID  *uint64       `json:"forfeit_winner_id,omitempty"`
+	Draw             bool          `json:"draw,omitempty"`
 	Status           string        `json:"status"`
+	ScheduledAt      *string       `json:"scheduled_at,omitempty"`
 	NextMatchID      *uint64       `json:"next_match_id,omitempty"`
+	LoserMatchID     *uint64       `json:"loser_match_id,omitempty"`
+
+	// Format the match is played under (omitted if it places no limits)
+	Format *MatchFormatResponse `json:"format,omitempty"`
+
+	// Score matches only: the final score, reported as the match's only set
+	Participant1Score *int `json:"participant1_score,omitempty"`
+	Participant2Score *int `json:"participant2_score,omitempty"`
+
+	Participant1Disqualified bool `json:"participant1_disqualified,omitempty"`
+	Participant2Disqualified bool `json:"participant2_disqualified,omitempty"`
+	Participant1Withdrawn    bool `json:"participant1_withdrawn,omitempty"`
+	Participant2Withdrawn    bool `json:"participant2_withdrawn,omitempty"`
+
+	// Results reported by the participants and not yet confirmed
+	Reports []MatchReportResponse `json:"reports,omitempty"`
+
+	// Called matches only: when check-in closes and who has checked in
+	CheckInDeadline       *string `json:"check_in_deadline,omitempty"`
+	Participant1CheckedIn bool    `json:"participant1_checked_in,omitempty"`
+	Participant2CheckedIn bool    `json:"participant2_checked_in,omitempty"`
+
+	// Free-for-all heats only
+	Slots        []SlotResponse `json:"slots,omitempty"`
+	AdvanceCount int            `json:"advance_count,omitempty"`
+
+	// Bracket previews only: who plays here if every match goes to the better seed
+	Projected1 *ProjectedParticipantResponse `json:"projected_participant1,omitempty"`
+	Projected2 *ProjectedParticipantResponse `json:"projected_participant2,omitempty"`
 }
 
 func (h *BracketHandler) Generate(w http.ResponseWriter, r *http.Request) {
@@ -86,22 +216,199 @@ func (h *BracketHandler) Generate(w http.ResponseWriter, r *http.Request) {
 		req.Format = "single_elimination"
 	}
 
-	if req.Format != "single_elimination" {
-		writeError(w, http.StatusBadRequest, "only single_elimination format is currently supported")
+	var state *service.BracketState
+	var err error
+	switch req.Format {
+	case "single_elimination":
+		state, err = h.bracketSvc.GenerateSingleElimination(r.Context(), req.TournamentID, req.Participants)
+	case "double_elimination":
+		state, err = h.bracketSvc.GenerateDoubleElimination(r.Context(), req.TournamentID, req.Participants)
+	case "round_robin":
+		state, err = h.bracketSvc.GenerateRoundRobin(r.Context(), req.TournamentID, req.Participants)
+	case "swiss":
+		state, err = h.bracketSvc.GenerateSwiss(r.Context(), req.TournamentID, req.Participants)
+	case "multi_stage":
+		state, err = h.bracketSvc.GenerateMultiStage(r.Context(), req.TournamentID, req.Participants)
+	case "free_for_all":
+		state, err = h.heatSvc.GenerateFreeForAll(r.Context(), req.TournamentID, req.Participants)
+	default:
+		writeError(w, http.StatusBadRequest, "format must be 'single_elimination', 'double_elimination', 'round_robin', 'swiss', 'multi_stage' or 'free_for_all'")
+		return
+	}
+	if errors.Is(err, service.ErrNoStages) {
+		writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	state, err := h.bracketSvc.GenerateSingleElimination(r.Context(), req.TournamentID, req.Participants)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	// Heats are generated with their slots
+	if err := attachSlots(r.Context(), h.slotRepo, state.Matches); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	resp := toBracketResponse(state)
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(resp)
 }
 
+// Preview generates a bracket without saving it, so organizers can check the layout
+// and the projected path of each seed before starting.
+func (h *BracketHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	var req GenerateBracketRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if len(req.Participants) < 2 {
+		writeError(w, http.StatusBadRequest, "at least 2 participants required")
+		return
+	}
+
+	// Default to single elimination
+	if req.Format == "" {
+		req.Format = "single_elimination"
+	}
+
+	state, err := h.bracketSvc.PreviewBracket(r.Context(), req.TournamentID, req.Format, req.Participants)
+	if errors.Is(err, service.ErrUnsupportedFormat) {
+		writeError(w, http.StatusBadRequest, "format must be 'single_elimination', 'double_elimination', 'round_robin', 'swiss', 'multi_stage' or 'free_for_all'")
+		return
+	}
+	if errors.Is(err, service.ErrNoStages) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toBracketResponse(state))
+}
+
+// Regenerate replaces a tournament's bracket with a freshly generated one, for fixing
+// seeding before any match has been played.
+func (h *BracketHandler) Regenerate(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+
+	if !requireOrganizer(w, r, tournamentID, "only the tournament organizer can regenerate the bracket") {
+		return
+	}
+
+	var req GenerateBracketRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if len(req.Participants) < 2 {
+		writeError(w, http.StatusBadRequest, "at least 2 participants required")
+		return
+	}
+
+	// Default to single elimination
+	if req.Format == "" {
+		req.Format = "single_elimination"
+	}
+
+	state, err := h.bracketSvc.RegenerateBracket(r.Context(), tournamentID, req.Format, req.Participants)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnsupportedFormat):
+			writeError(w, http.StatusBadRequest, "format must be 'single_elimination', 'double_elimination', 'round_robin', 'swiss', 'multi_stage' or 'free_for_all'")
+		case errors.Is(err, service.ErrNoStages), errors.Is(err, service.ErrInvalidParticipants):
+			writeError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, service.ErrBracketStarted):
+			writeError(w, http.StatusConflict, "bracket cannot be regenerated once a match has been played")
+		default:
+			writeError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	json.NewEncoder(w).Encode(toBracketResponse(state))
+}
+
+// LateEntry places a participant who registered after the bracket was generated into
+// an open first round bye.
+func (h *BracketHandler) LateEntry(w http.ResponseWriter, r *http.Request) {
+	var req LateEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.TournamentID == 0 || req.ParticipantID == 0 {
+		writeError(w, http.StatusBadRequest, "tournament_id and participant_id required")
+		return
+	}
+
+	participant := domain.Participant{
+		ID:   req.ParticipantID,
+		Name: req.ParticipantName,
+		Seed: req.Seed,
+	}
+	match, err := h.matchSvc.AddLateEntrant(r.Context(), req.TournamentID, participant)
+	if errors.Is(err, service.ErrNoOpenByeSlot) {
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toMatchResponse(match))
+}
+
+// Substitute hands a participant's pending and ready matches to a substitute.
+func (h *BracketHandler) Substitute(w http.ResponseWriter, r *http.Request) {
+	var req SubstituteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.TournamentID == 0 || req.ParticipantID == 0 || req.SubstituteID == 0 {
+		writeError(w, http.StatusBadRequest, "tournament_id, participant_id and substitute_id required")
+		return
+	}
+
+	substitute := domain.Participant{
+		ID:   req.SubstituteID,
+		Name: req.SubstituteName,
+		Seed: req.SubstituteSeed,
+	}
+	matches, err := h.matchSvc.SubstituteParticipant(r.Context(), req.TournamentID, req.ParticipantID, substitute)
+	if errors.Is(err, service.ErrMatchInProgress) {
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if errors.Is(err, service.ErrSubstituteInStage) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := make([]*MatchResponse, len(matches))
+	for i, m := range matches {
+		resp[i] = toMatchResponse(m)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
 func (h *BracketHandler) GetState(w http.ResponseWriter, r *http.Request) {
 	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
 	if err != nil {
@@ -138,10 +445,108 @@ func (h *BracketHandler) GetState(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if err := attachSlots(r.Context(), h.slotRepo, state.Matches); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := toBracketResponse(state)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// NextRound pairs the next round of a Swiss event.
+func (h *BracketHandler) NextRound(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+
+	if !requireOrganizer(w, r, tournamentID, "only the tournament organizer can start the next round") {
+		return
+	}
+
+	state, err := h.bracketSvc.NextSwissRound(r.Context(), tournamentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotSwiss):
+			writeError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, service.ErrRoundInProgress), errors.Is(err, service.ErrSwissComplete):
+			writeError(w, http.StatusConflict, err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
 	resp := toBracketResponse(state)
+	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(resp)
 }
 
+func (h *BracketHandler) GetStandings(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+
+	standings, err := h.matchSvc.GetStandings(r.Context(), tournamentID)
+	if err != nil {
+		if errors.Is(err, service.ErrNoStandings) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toStandingsResponse(standings))
+}
+
+// GetSchedule returns the tournament's projected timeline.
+func (h *BracketHandler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+
+	matches, err := h.repo.GetByTournament(r.Context(), tournamentID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toScheduleResponse(tournamentID, matches))
+}
+
+// Schedule recomputes the tournament's timeline, e.g. after its start time or schedule
+// settings changed. Results recompute it on their own.
+func (h *BracketHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+
+	if !requireOrganizer(w, r, tournamentID, "only the tournament organizer can schedule matches") {
+		return
+	}
+
+	matches, err := h.matchSvc.ScheduleMatches(r.Context(), tournamentID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotScheduled) {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toScheduleResponse(tournamentID, matches))
+}
+
 func (h *BracketHandler) ListMatches(w http.ResponseWriter, r *http.Request) {
 	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
 	if err != nil {
@@ -173,6 +578,11 @@ func (h *BracketHandler) ListMatches(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if err := attachSlots(r.Context(), h.slotRepo, matches); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	resp := make([]*MatchResponse, len(matches))
 	for i, m := range matches {
 		resp[i] = toMatchResponse(m)
@@ -185,16 +595,105 @@ func toBracketResponse(state *service.BracketState) *BracketResponse {
 	matches := make([]*MatchResponse, len(state.Matches))
 	for i, m := range state.Matches {
 		matches[i] = toMatchResponse(m)
+		if projection, ok := state.Projections[m.ID]; ok {
+			matches[i].Projected1 = toProjectedParticipantResponse(projection.Participant1)
+			matches[i].Projected2 = toProjectedParticipantResponse(projection.Participant2)
+		}
 	}
 
-	return &BracketResponse{
+	resp := &BracketResponse{
 		TournamentID: state.TournamentID,
+		Stage:        state.Stage,
 		TotalRounds:  state.TotalRounds,
 		CurrentRound: state.CurrentRound,
 		IsComplete:   state.IsComplete,
 		ChampionID:   state.ChampionID,
 		Matches:      matches,
 	}
+	for _, c := range state.SeedingConflicts {
+		resp.SeedingConflicts = append(resp.SeedingConflicts, SeedingConflictResponse{
+			Tag:            c.Tag,
+			Participant1ID: c.Participant1ID,
+			Participant2ID: c.Participant2ID,
+			Round:          c.Round,
+		})
+	}
+	return resp
+}
+
+func toScheduleResponse(tournamentID uint64, matches []*domain.Match) *ScheduleResponse {
+	var scheduled []*domain.Match
+	for _, m := range matches {
+		if m.ScheduledAt != nil {
+			scheduled = append(scheduled, m)
+		}
+	}
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return scheduled[i].ScheduledAt.Before(*scheduled[j].ScheduledAt)
+	})
+
+	resp := &ScheduleResponse{
+		TournamentID: tournamentID,
+		Matches:      make([]*MatchResponse, len(scheduled)),
+	}
+	for i, m := range scheduled {
+		resp.Matches[i] = toMatchResponse(m)
+	}
+	return resp
+}
+
+func toStandingsResponse(standings *service.Standings) *StandingsResponse {
+	tiebreakers := make([]string, len(standings.Tiebreakers))
+	for i, t := range standings.Tiebreakers {
+		tiebreakers[i] = string(t)
+	}
+
+	entries := make([]*StandingResponse, len(standings.Entries))
+	for i, e := range standings.Entries {
+		entries[i] = &StandingResponse{
+			Rank:            e.Rank,
+			Pool:            e.Pool,
+			ParticipantID:   e.ParticipantID,
+			ParticipantName: e.Name,
+			Seed:            e.Seed,
+			Played:          e.Played,
+			Wins:            e.Wins,
+			Draws:           e.Draws,
+			Losses:          e.Losses,
+			Byes:            e.Byes,
+			SetsWon:         e.SetsWon,
+			SetsLost:        e.SetsLost,
+			SetDifferential: e.SetDifferential(),
+
+			Buchholz:              e.Buchholz,
+			OpponentWinPercentage: e.OpponentWinPercentage,
+		}
+	}
+
+	return &StandingsResponse{
+		TournamentID: standings.TournamentID,
+		Stage:        standings.Stage,
+		Tiebreakers:  tiebreakers,
+		Standings:    entries,
+	}
+}
+
+func toProjectedParticipantResponse(p *domain.Participant) *ProjectedParticipantResponse {
+	if p == nil {
+		return nil
+	}
+	return &ProjectedParticipantResponse{
+		ParticipantID:   p.ID,
+		ParticipantName: p.Name,
+		Seed:            p.Seed,
+	}
+}
+
+type MatchFormatResponse struct {
+	ScoringMode   string `json:"scoring_mode"`
+	BestOf        int    `json:"best_of,omitempty"`
+	SetsToWin     int    `json:"sets_to_win,omitempty"`
+	AllowTiedSets bool   `json:"allow_tied_sets"`
 }
 
 func toMatchResponse(m *domain.Match) *MatchResponse {
@@ -214,8 +713,36 @@ func toMatchResponse(m *domain.Match) *MatchResponse {
 		}
 	}
 
-	return &MatchResponse{
+	// Convert heat slots
+	var slots []SlotResponse
+	for _, s := range m.Slots {
+		slots = append(slots, SlotResponse{
+			SlotNumber:      s.SlotNumber,
+			ParticipantID:   s.ParticipantID,
+			ParticipantName: s.ParticipantName,
+			Seed:            s.Seed,
+			Placement:       s.Placement,
+		})
+	}
+
+	var format *MatchFormatResponse
+	if m.Format != (domain.MatchFormat{}) {
+		scoring := m.Format.Scoring
+		if scoring == "" {
+			scoring = domain.ScoringSets
+		}
+		format = &MatchFormatResponse{
+			ScoringMode:   string(scoring),
+			BestOf:        m.Format.BestOf,
+			SetsToWin:     m.Format.SetsToWin,
+			AllowTiedSets: m.Format.AllowTiedSets,
+		}
+	}
+
+	resp := &MatchResponse{
 		ID:               m.ID,
+		Stage:            m.Stage,
+		Pool:             m.Pool,
 		Round:            m.Round,
 		Position:         m.Position,
 		BracketType:      string(m.BracketType),
@@ -230,9 +757,74 @@ func toMatchResponse(m *domain.Match) *MatchResponse {
 		Participant2Sets: p2Sets,
 		WinnerID:         m.WinnerID,
 		ForfeitWinnerID:  m.ForfeitWinnerID,
+		Draw:             m.Draw,
 		Status:           string(m.Status),
 		NextMatchID:      m.NextMatchID,
+		LoserMatchID:     m.LoserMatchID,
+		Slots:            slots,
+		AdvanceCount:     m.AdvanceCount,
+		Format:           format,
+
+		Participant1Disqualified: m.Participant1Disqualified,
+		Participant2Disqualified: m.Participant2Disqualified,
+		Participant1Withdrawn:    m.Participant1Withdrawn,
+		Participant2Withdrawn:    m.Participant2Withdrawn,
+		Participant1CheckedIn:    m.Participant1CheckedIn,
+		Participant2CheckedIn:    m.Participant2CheckedIn,
+	}
+
+	if m.ScheduledAt != nil {
+		scheduledAt := m.ScheduledAt.Format(time.RFC3339)
+		resp.ScheduledAt = &scheduledAt
+	}
+	for _, report := range m.Reports {
+		sets := make([]SetResponse, len(report.Result.Sets))
+		for i, set := range report.Result.Sets {
+			sets[i] = SetResponse{
+				SetNumber:         set.SetNumber,
+				Participant1Score: set.Participant1Score,
+				Participant2Score: set.Participant2Score,
+			}
+		}
+		resp.Reports = append(resp.Reports, MatchReportResponse{
+			ParticipantID:   report.ParticipantID,
+			Sets:            sets,
+			Outcome:         string(report.Result.Outcome),
+			ConfirmDeadline: report.ConfirmDeadline.Format(time.RFC3339),
+		})
+	}
+	if m.Status == domain.MatchCalled && m.CheckInDeadline != nil {
+		deadline := m.CheckInDeadline.Format(time.RFC3339)
+		resp.CheckInDeadline = &deadline
+	}
+	if m.Format.Scoring == domain.ScoringScore && len(m.Sets) == 1 {
+		resp.Participant1Score = &m.Sets[0].Participant1Score
+		resp.Participant2Score = &m.Sets[0].Participant2Score
+	}
+
+	return resp
+}
+
+// attachSlots loads the slots of any free-for-all heats among matches.
+func attachSlots(ctx context.Context, slotRepo repository.SlotRepository, matches []*domain.Match) error {
+	var heatIDs []uint64
+	for _, m := range matches {
+		if m.BracketType == domain.BracketHeat {
+			heatIDs = append(heatIDs, m.ID)
+		}
+	}
+	if len(heatIDs) == 0 {
+		return nil
+	}
+
+	slotsMap, err := slotRepo.GetByMatchIDs(ctx, heatIDs)
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		m.Slots = slotsMap[m.ID]
 	}
+	return nil
 }
 
 func writeError(w http.ResponseWriter, status int, message string) {
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+type EventHandler struct {
+	eventRepo repository.EventRepository
+}
+
+func NewEventHandler(eventRepo repository.EventRepository) *EventHandler {
+	return &EventHandler{eventRepo: eventRepo}
+}
+
+// MatchEventResponse is one change to a match's result in the audit log.
+type MatchEventResponse struct {
+	ID        uint64                `json:"id"`
+	MatchID   uint64                `json:"match_id"`
+	Type      string                `json:"type"`
+	UserID    *uint64               `json:"user_id,omitempty"`
+	Reason    string                `json:"reason,omitempty"`
+	Before    MatchSnapshotResponse `json:"before"`
+	After     MatchSnapshotResponse `json:"after"`
+	CreatedAt string                `json:"created_at"`
+}
+
+// MatchSnapshotResponse is a match's result before or after a change.
+type MatchSnapshotResponse struct {
+	Completed       bool               `json:"completed"`
+	WinnerID        *uint64            `json:"winner_id,omitempty"`
+	ForfeitWinnerID *uint64            `json:"forfeit_winner_id,omitempty"`
+	Draw            bool               `json:"draw,omitempty"`
+	Sets            []SetResponse      `json:"sets"`
+	Placements      []domain.Placement `json:"placements,omitempty"`
+}
+
+// MatchHistory returns every change made to a match's result, oldest first.
+func (h *EventHandler) MatchHistory(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid match ID")
+		return
+	}
+
+	events, err := h.eventRepo.GetByMatchID(r.Context(), id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toMatchEventResponses(events))
+}
+
+// TournamentHistory returns every change made to the results of a tournament's
+// matches, oldest first.
+func (h *EventHandler) TournamentHistory(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+
+	events, err := h.eventRepo.GetByTournament(r.Context(), tournamentID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toMatchEventResponses(events))
+}
+
+func toMatchEventResponses(events []domain.MatchEvent) []MatchEventResponse {
+	response := make([]MatchEventResponse, len(events))
+	for i, e := range events {
+		response[i] = MatchEventResponse{
+			ID:        e.ID,
+			MatchID:   e.MatchID,
+			Type:      string(e.Type),
+			UserID:    e.UserID,
+			Reason:    e.Reason,
+			Before:    toMatchSnapshotResponse(e.Before),
+			After:     toMatchSnapshotResponse(e.After),
+			CreatedAt: e.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return response
+}
+
+func toMatchSnapshotResponse(s domain.MatchSnapshot) MatchSnapshotResponse {
+	sets := make([]SetResponse, len(s.Sets))
+	for i, set := range s.Sets {
+		sets[i] = SetResponse{
+			SetNumber:         set.SetNumber,
+			Participant1Score: set.Participant1Score,
+			Participant2Score: set.Participant2Score,
+		}
+	}
+	return MatchSnapshotResponse{
+		Completed:       s.Completed,
+		WinnerID:        s.WinnerID,
+		ForfeitWinnerID: s.ForfeitWinnerID,
+		Draw:            s.Draw,
+		Sets:            sets,
+		Placements:      s.Placements,
+	}
+}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/braccet/bracket/internal/service"
@@ -9,20 +11,34 @@ import (
 
 type ForfeitHandler struct {
 	forfeitSvc service.ForfeitService
+	matchSvc   service.MatchService
 }
 
-func NewForfeitHandler(forfeitSvc service.ForfeitService) *ForfeitHandler {
-	return &ForfeitHandler{forfeitSvc: forfeitSvc}
+func NewForfeitHandler(forfeitSvc service.ForfeitService, matchSvc service.MatchService) *ForfeitHandler {
+	return &ForfeitHandler{forfeitSvc: forfeitSvc, matchSvc: matchSvc}
 }
 
+// ForfeitParticipantRequest withdraws a participant. UserID is who withdrew them, the
+// participant themselves or the organizer, and is kept in the audit log.
 type ForfeitParticipantRequest struct {
 	TournamentID  uint64 `json:"tournament_id"`
 	ParticipantID uint64 `json:"participant_id"`
+	UserID        uint64 `json:"user_id,omitempty"`
+}
+
+// DisqualifyParticipantRequest disqualifies a participant. UserID is the organizer who
+// disqualified them, and is kept in the audit log.
+type DisqualifyParticipantRequest struct {
+	TournamentID     uint64 `json:"tournament_id"`
+	ParticipantID    uint64 `json:"participant_id"`
+	UserID           uint64 `json:"user_id,omitempty"`
+	VoidCurrentRound bool   `json:"void_current_round"` // Also void results already played in the current round
 }
 
 type ForfeitResponse struct {
 	ForfeitedMatches []uint64 `json:"forfeited_matches"`
 	AdvancedWinners  []uint64 `json:"advanced_winners"`
+	VoidedMatches    []uint64 `json:"voided_matches,omitempty"`
 }
 
 // ForfeitParticipant processes a participant withdrawal by forfeiting their matches.
@@ -38,7 +54,7 @@ func (h *ForfeitHandler) ForfeitParticipant(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	summary, err := h.forfeitSvc.ProcessWithdrawal(r.Context(), req.TournamentID, req.ParticipantID)
+	summary, err := h.forfeitSvc.ProcessWithdrawal(actorContext(r, req.UserID), req.TournamentID, req.ParticipantID)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -52,3 +68,46 @@ func (h *ForfeitHandler) ForfeitParticipant(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(resp)
 }
+
+// DisqualifyParticipant processes a disqualification: the participant's open matches are
+// forfeited and, if asked, their results in the current round are voided.
+func (h *ForfeitHandler) DisqualifyParticipant(w http.ResponseWriter, r *http.Request) {
+	var req DisqualifyParticipantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.TournamentID == 0 || req.ParticipantID == 0 {
+		writeError(w, http.StatusBadRequest, "tournament_id and participant_id required")
+		return
+	}
+
+	summary, err := h.matchSvc.Disqualify(actorContext(r, req.UserID), req.TournamentID, req.ParticipantID, req.VoidCurrentRound)
+	if errors.Is(err, service.ErrStageLocked) {
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := ForfeitResponse{
+		ForfeitedMatches: summary.ForfeitedMatches,
+		AdvancedWinners:  summary.AdvancedWinners,
+		VoidedMatches:    summary.VoidedMatches,
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// actorContext attributes the result changes made while handling a request from the
+// tournament service to the user who made the request there, if it was forwarded.
+func actorContext(r *http.Request, userID uint64) context.Context {
+	if userID == 0 {
+		return r.Context()
+	}
+	return service.WithActor(r.Context(), userID, "")
+}
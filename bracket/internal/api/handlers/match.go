@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strconv"
@@ -17,16 +18,35 @@ import (
 )
 
 type MatchHandler struct {
-	matchSvc service.MatchService
-	repo     repository.MatchRepository
-	setRepo  repository.SetRepository
+	matchSvc   service.MatchService
+	heatSvc    service.HeatService
+	forfeitSvc service.ForfeitService
+	reportSvc  service.ReportService
+	repo       repository.MatchRepository
+	setRepo    repository.SetRepository
+	slotRepo   repository.SlotRepository
+	reportRepo repository.ReportRepository
 }
 
-func NewMatchHandler(matchSvc service.MatchService, repo repository.MatchRepository, setRepo repository.SetRepository) *MatchHandler {
+func NewMatchHandler(
+	matchSvc service.MatchService,
+	heatSvc service.HeatService,
+	forfeitSvc service.ForfeitService,
+	reportSvc service.ReportService,
+	repo repository.MatchRepository,
+	setRepo repository.SetRepository,
+	slotRepo repository.SlotRepository,
+	reportRepo repository.ReportRepository,
+) *MatchHandler {
 	return &MatchHandler{
-		matchSvc: matchSvc,
-		repo:     repo,
-		setRepo:  setRepo,
+		matchSvc:   matchSvc,
+		heatSvc:    heatSvc,
+		forfeitSvc: forfeitSvc,
+		reportSvc:  reportSvc,
+		repo:       repo,
+		setRepo:    setRepo,
+		slotRepo:   slotRepo,
+		reportRepo: reportRepo,
 	}
 }
 
@@ -36,8 +56,67 @@ type SetScoreRequest struct {
 	Participant2Score int `json:"participant2_score"`
 }
 
+// ReportResultRequest reports a match result in the match's scoring mode: sets, a
+// single final score, or a win/draw/loss outcome (participant1, participant2 or draw).
+// The reason is kept in the audit log.
 type ReportResultRequest struct {
-	Sets []SetScoreRequest `json:"sets"`
+	Sets    []SetScoreRequest `json:"sets"`
+	Score   *ScoreRequest     `json:"score,omitempty"`
+	Outcome string            `json:"outcome,omitempty"`
+	Reason  string            `json:"reason,omitempty"`
+}
+
+// ReasonRequest is the optional body of a result change that takes no other input.
+type ReasonRequest struct {
+	Reason string `json:"reason"`
+}
+
+type ScoreRequest struct {
+	Participant1Score int `json:"participant1_score"`
+	Participant2Score int `json:"participant2_score"`
+}
+
+// toMatchResult converts a result request to the domain model, returning a message
+// describing what is wrong with it if it cannot be. A score is stored as the only set.
+// Whether the parts reported fit the match's scoring mode is checked by the service.
+func toMatchResult(req ReportResultRequest) (domain.MatchResult, string) {
+	if req.Score != nil {
+		if len(req.Sets) > 0 {
+			return domain.MatchResult{}, "report either sets or a score, not both"
+		}
+		req.Sets = []SetScoreRequest{{
+			SetNumber:         1,
+			Participant1Score: req.Score.Participant1Score,
+			Participant2Score: req.Score.Participant2Score,
+		}}
+	}
+
+	if len(req.Sets) == 0 && req.Outcome == "" {
+		return domain.MatchResult{}, "at least one set is required"
+	}
+
+	// Validate set numbers are sequential starting from 1
+	for i, set := range req.Sets {
+		if set.SetNumber != i+1 {
+			return domain.MatchResult{}, "set numbers must be sequential starting from 1"
+		}
+	}
+
+	// Convert request to domain model
+	sets := make([]domain.SetScore, len(req.Sets))
+	for i, s := range req.Sets {
+		sets[i] = domain.SetScore{
+			SetNumber:         s.SetNumber,
+			Participant1Score: s.Participant1Score,
+			Participant2Score: s.Participant2Score,
+		}
+	}
+
+	return domain.MatchResult{Sets: sets, Outcome: domain.MatchOutcome(req.Outcome)}, ""
+}
+
+type ReportPlacementsRequest struct {
+	Placements []domain.Placement `json:"placements"`
 }
 
 func (h *MatchHandler) Get(w http.ResponseWriter, r *http.Request) {
@@ -65,9 +144,23 @@ func (h *MatchHandler) Get(w http.ResponseWriter, r *http.Request) {
 	}
 	match.Sets = sets
 
+	if err := attachSlots(r.Context(), h.slotRepo, []*domain.Match{match}); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Load results reported by the participants and not yet confirmed
+	match.Reports, err = h.reportRepo.GetByMatchID(r.Context(), id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	json.NewEncoder(w).Encode(toMatchResponse(match))
 }
 
+// ReportResult reports a match result. The organizer's result is final; a
+// participant's result waits for their opponent to confirm or dispute it.
 func (h *MatchHandler) ReportResult(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
@@ -75,70 +168,155 @@ func (h *MatchHandler) ReportResult(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Get user ID from context (requires auth middleware)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
 	var req ReportResultRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
-	if len(req.Sets) == 0 {
-		writeError(w, http.StatusBadRequest, "at least one set is required")
+	result, msg := toMatchResult(req)
+	if msg != "" {
+		writeError(w, http.StatusBadRequest, msg)
 		return
 	}
 
-	// Validate set numbers are sequential starting from 1
-	for i, set := range req.Sets {
-		if set.SetNumber != i+1 {
-			writeError(w, http.StatusBadRequest, "set numbers must be sequential starting from 1")
-			return
-		}
+	ctx := service.WithActor(r.Context(), userID, req.Reason)
+	match, err := h.reportSvc.ReportResult(ctx, id, userID, result)
+	if err != nil {
+		writeReportError(w, err)
+		return
 	}
 
-	// Convert request to domain model
-	sets := make([]domain.SetScore, len(req.Sets))
-	for i, s := range req.Sets {
-		sets[i] = domain.SetScore{
-			SetNumber:         s.SetNumber,
-			Participant1Score: s.Participant1Score,
-			Participant2Score: s.Participant2Score,
-		}
+	h.writeReportedMatch(w, r, match)
+}
+
+// ConfirmResult confirms the result the opponent of the authenticated participant
+// reported, completing the match.
+func (h *MatchHandler) ConfirmResult(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid match ID")
+		return
 	}
 
-	result := domain.MatchResult{Sets: sets}
+	// Get user ID from context (requires auth middleware)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx := service.WithActor(r.Context(), userID, "")
+	match, err := h.reportSvc.ConfirmResult(ctx, id, userID)
+	if err != nil {
+		writeReportError(w, err)
+		return
+	}
+
+	h.writeReportedMatch(w, r, match)
+}
 
-	err = h.matchSvc.ReportResult(r.Context(), id, result)
+// writeReportedMatch writes a match that was just reported or confirmed, with its sets.
+func (h *MatchHandler) writeReportedMatch(w http.ResponseWriter, r *http.Request, match *domain.Match) {
+	sets, err := h.setRepo.GetByMatchID(r.Context(), match.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	match.Sets = sets
+
+	json.NewEncoder(w).Encode(toMatchResponse(match))
+}
+
+func writeReportError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, repository.ErrMatchNotFound):
+		writeError(w, http.StatusNotFound, "match not found")
+	case errors.Is(err, service.ErrNotReporter):
+		writeError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, service.ErrMatchDisputed), errors.Is(err, service.ErrNoReport):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrMatchNotReady):
+		writeError(w, http.StatusBadRequest, "match is not ready for result reporting")
+	case errors.Is(err, service.ErrSetsTied):
+		writeError(w, http.StatusBadRequest, "sets are tied - there must be a clear winner")
+	case errors.Is(err, service.ErrNoSets):
+		writeError(w, http.StatusBadRequest, "at least one set is required")
+	case errors.Is(err, service.ErrTiedSet), errors.Is(err, service.ErrSetAfterDecided), errors.Is(err, service.ErrTooFewSets),
+		errors.Is(err, service.ErrDrawNotAllowed), errors.Is(err, service.ErrSingleScore), errors.Is(err, service.ErrNoOutcome):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, service.ErrMatchAlreadyComplete):
+		writeError(w, http.StatusBadRequest, "match has already been completed")
+	case errors.Is(err, service.ErrHeatMatch):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// ReportPlacements records the finishing order of a free-for-all heat.
+func (h *MatchHandler) ReportPlacements(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid match ID")
+		return
+	}
+
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req ReportPlacementsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	// Only the organizer and the players racing in the heat can report it
+	ctx := service.WithActor(r.Context(), userID, "")
+	err = h.heatSvc.CheckReporter(ctx, id, userID)
+	if err == nil {
+		err = h.heatSvc.ReportPlacements(ctx, id, req.Placements)
+	}
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrMatchNotFound):
 			writeError(w, http.StatusNotFound, "match not found")
+		case errors.Is(err, service.ErrNotReporter):
+			writeError(w, http.StatusForbidden, err.Error())
 		case errors.Is(err, service.ErrMatchNotReady):
 			writeError(w, http.StatusBadRequest, "match is not ready for result reporting")
-		case errors.Is(err, service.ErrSetsTied):
-			writeError(w, http.StatusBadRequest, "sets are tied - there must be a clear winner")
-		case errors.Is(err, service.ErrNoSets):
-			writeError(w, http.StatusBadRequest, "at least one set is required")
 		case errors.Is(err, service.ErrMatchAlreadyComplete):
 			writeError(w, http.StatusBadRequest, "match has already been completed")
+		case errors.Is(err, service.ErrNotHeat),
+			errors.Is(err, service.ErrInvalidPlacements),
+			errors.Is(err, service.ErrInvalidWinner):
+			writeError(w, http.StatusBadRequest, err.Error())
 		default:
 			writeError(w, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
-	// Return updated match with sets
+	// Return updated heat with placements
 	match, err := h.repo.GetByID(r.Context(), id)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	// Load sets for the updated match
-	matchSets, err := h.setRepo.GetByMatchID(r.Context(), id)
-	if err != nil {
+	if err := attachSlots(r.Context(), h.slotRepo, []*domain.Match{match}); err != nil {
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	match.Sets = matchSets
 
 	json.NewEncoder(w).Encode(toMatchResponse(match))
 }
@@ -181,6 +359,92 @@ func (h *MatchHandler) Start(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(toMatchResponse(match))
 }
 
+// CheckInRequest is the optional body of a check-in. Participants check themselves in
+// and can leave it out; the organizer names the participant they check in.
+type CheckInRequest struct {
+	ParticipantID *uint64 `json:"participant_id,omitempty"`
+}
+
+// Call calls a ready match to be played, opening its check-in window.
+func (h *MatchHandler) Call(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid match ID")
+		return
+	}
+
+	match, err := h.repo.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			writeError(w, http.StatusNotFound, "match not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if !requireOrganizer(w, r, match.TournamentID, "only the tournament organizer can call matches") {
+		return
+	}
+
+	match, err = h.matchSvc.CallMatch(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMatchNotReady):
+			writeError(w, http.StatusBadRequest, "match is not ready to be called")
+		case errors.Is(err, service.ErrHeatMatch):
+			writeError(w, http.StatusBadRequest, err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	json.NewEncoder(w).Encode(toMatchResponse(match))
+}
+
+// CheckIn confirms that the authenticated participant of a called match is present, or
+// that the participant named by the organizer is.
+func (h *MatchHandler) CheckIn(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid match ID")
+		return
+	}
+
+	// Get user ID from context (requires auth middleware)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req CheckInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	match, err := h.matchSvc.CheckIn(r.Context(), id, userID, req.ParticipantID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrMatchNotFound):
+			writeError(w, http.StatusNotFound, "match not found")
+		case errors.Is(err, service.ErrMatchNotCalled):
+			writeError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, service.ErrNotCheckInPlayer):
+			writeError(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, service.ErrNotInMatch):
+			writeError(w, http.StatusBadRequest, err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	json.NewEncoder(w).Encode(toMatchResponse(match))
+}
+
 func (h *MatchHandler) Reopen(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
@@ -207,7 +471,7 @@ func (h *MatchHandler) Reopen(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Verify user is tournament organizer
-	isOrganizer, err := h.verifyOrganizer(match.TournamentID, userID)
+	isOrganizer, err := verifyOrganizer(match.TournamentID, userID)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to verify permissions")
 		return
@@ -217,12 +481,22 @@ func (h *MatchHandler) Reopen(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	reason, ok := decodeReason(w, r)
+	if !ok {
+		return
+	}
+
 	// Call service to reopen match
-	reopenedMatches, err := h.matchSvc.ReopenMatch(r.Context(), id)
+	ctx := service.WithActor(r.Context(), userID, reason)
+	reopenedMatches, err := h.matchSvc.ReopenMatch(ctx, id)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrMatchNotCompleted):
 			writeError(w, http.StatusBadRequest, "match is not completed")
+		case errors.Is(err, service.ErrStageLocked):
+			writeError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, service.ErrHeatMatch):
+			writeError(w, http.StatusBadRequest, err.Error())
 		default:
 			writeError(w, http.StatusInternalServerError, err.Error())
 		}
@@ -240,6 +514,68 @@ func (h *MatchHandler) Reopen(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// DoubleForfeit records that neither participant showed up for a match. The match
+// completes with no winner and the next match treats its slot as a bye.
+func (h *MatchHandler) DoubleForfeit(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid match ID")
+		return
+	}
+
+	// Get user ID from context (requires auth middleware)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	// Get match to find tournament ID
+	match, err := h.repo.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			writeError(w, http.StatusNotFound, "match not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Verify user is tournament organizer
+	isOrganizer, err := verifyOrganizer(match.TournamentID, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to verify permissions")
+		return
+	}
+	if !isOrganizer {
+		writeError(w, http.StatusForbidden, "only the tournament organizer can record a double forfeit")
+		return
+	}
+
+	reason, ok := decodeReason(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := service.WithActor(r.Context(), userID, reason)
+	match, err = h.forfeitSvc.DoubleForfeit(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMatchAlreadyComplete):
+			writeError(w, http.StatusConflict, "match has already been completed")
+		case errors.Is(err, service.ErrMatchNotReady):
+			writeError(w, http.StatusBadRequest, "match does not have both participants yet")
+		case errors.Is(err, service.ErrHeatMatch):
+			writeError(w, http.StatusBadRequest, err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	json.NewEncoder(w).Encode(toMatchResponse(match))
+}
+
 type EditResultResponse struct {
 	Match          *MatchResponse   `json:"match"`
 	CascadeMatches []*MatchResponse `json:"cascade_matches,omitempty"`
@@ -272,7 +608,7 @@ func (h *MatchHandler) EditResult(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Verify user is tournament organizer
-	isOrganizer, err := h.verifyOrganizer(match.TournamentID, userID)
+	isOrganizer, err := verifyOrganizer(match.TournamentID, userID)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to verify permissions")
 		return
@@ -288,42 +624,31 @@ func (h *MatchHandler) EditResult(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if len(req.Sets) == 0 {
-		writeError(w, http.StatusBadRequest, "at least one set is required")
+	result, msg := toMatchResult(req)
+	if msg != "" {
+		writeError(w, http.StatusBadRequest, msg)
 		return
 	}
 
-	// Validate set numbers are sequential starting from 1
-	for i, set := range req.Sets {
-		if set.SetNumber != i+1 {
-			writeError(w, http.StatusBadRequest, "set numbers must be sequential starting from 1")
-			return
-		}
-	}
-
-	// Convert request to domain model
-	sets := make([]domain.SetScore, len(req.Sets))
-	for i, s := range req.Sets {
-		sets[i] = domain.SetScore{
-			SetNumber:         s.SetNumber,
-			Participant1Score: s.Participant1Score,
-			Participant2Score: s.Participant2Score,
-		}
-	}
-
-	result := domain.MatchResult{Sets: sets}
-
-	editResponse, err := h.matchSvc.EditResult(r.Context(), id, result)
+	ctx := service.WithActor(r.Context(), userID, req.Reason)
+	editResponse, err := h.matchSvc.EditResult(ctx, id, result)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrMatchNotFound):
 			writeError(w, http.StatusNotFound, "match not found")
 		case errors.Is(err, service.ErrMatchNotCompleted):
 			writeError(w, http.StatusBadRequest, "match is not completed")
+		case errors.Is(err, service.ErrStageLocked):
+			writeError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, service.ErrHeatMatch):
+			writeError(w, http.StatusBadRequest, err.Error())
 		case errors.Is(err, service.ErrSetsTied):
 			writeError(w, http.StatusBadRequest, "sets are tied - there must be a clear winner")
 		case errors.Is(err, service.ErrNoSets):
 			writeError(w, http.StatusBadRequest, "at least one set is required")
+		case errors.Is(err, service.ErrTiedSet), errors.Is(err, service.ErrSetAfterDecided), errors.Is(err, service.ErrTooFewSets),
+			errors.Is(err, service.ErrDrawNotAllowed), errors.Is(err, service.ErrSingleScore), errors.Is(err, service.ErrNoOutcome):
+			writeError(w, http.StatusBadRequest, err.Error())
 		default:
 			writeError(w, http.StatusInternalServerError, err.Error())
 		}
@@ -345,8 +670,19 @@ func (h *MatchHandler) EditResult(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// decodeReason reads the reason for a result change from an optional request body,
+// writing the error response if the body is invalid.
+func decodeReason(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var req ReasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return "", false
+	}
+	return req.Reason, true
+}
+
 // verifyOrganizer checks if the user is the organizer of the tournament
-func (h *MatchHandler) verifyOrganizer(tournamentID, userID uint64) (bool, error) {
+func verifyOrganizer(tournamentID, userID uint64) (bool, error) {
 	tournamentServiceURL := os.Getenv("TOURNAMENT_SERVICE_URL")
 	if tournamentServiceURL == "" {
 		tournamentServiceURL = "http://localhost:8083"
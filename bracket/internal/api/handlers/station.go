@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/braccet/bracket/internal/api/middleware"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/repository"
+	"github.com/braccet/bracket/internal/service"
+)
+
+type StationHandler struct {
+	stationSvc service.StationService
+}
+
+func NewStationHandler(stationSvc service.StationService) *StationHandler {
+	return &StationHandler{stationSvc: stationSvc}
+}
+
+// StationRequest creates or updates a station. Active defaults to true.
+type StationRequest struct {
+	Name   string `json:"name"`
+	Stream bool   `json:"stream"`
+	Active *bool  `json:"active,omitempty"`
+}
+
+type StationResponse struct {
+	ID     uint64         `json:"id"`
+	Name   string         `json:"name"`
+	Stream bool           `json:"stream"`
+	Active bool           `json:"active"`
+	Match  *MatchResponse `json:"match,omitempty"`
+}
+
+// StationQueueResponse is a tournament's stations with the matches on them, and the
+// ready matches waiting for a station in the order they will be assigned.
+type StationQueueResponse struct {
+	TournamentID uint64             `json:"tournament_id"`
+	Stations     []*StationResponse `json:"stations"`
+	Waiting      []*MatchResponse   `json:"waiting"`
+}
+
+// GetQueue returns the tournament's stations and match queue.
+func (h *StationHandler) GetQueue(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+
+	queue, err := h.stationSvc.GetQueue(r.Context(), tournamentID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toStationQueueResponse(queue))
+}
+
+// Create adds a station to the tournament (organizer only).
+func (h *StationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+	if !requireOrganizer(w, r, tournamentID, "only the tournament organizer can manage stations") {
+		return
+	}
+
+	station, ok := decodeStation(w, r)
+	if !ok {
+		return
+	}
+	station.TournamentID = tournamentID
+
+	queue, err := h.stationSvc.CreateStation(r.Context(), station)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(toStationQueueResponse(queue))
+}
+
+// Update renames a station or changes its stream and active flags (organizer only).
+func (h *StationHandler) Update(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+	stationID, err := strconv.ParseUint(chi.URLParam(r, "stationId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid station ID")
+		return
+	}
+	if !requireOrganizer(w, r, tournamentID, "only the tournament organizer can manage stations") {
+		return
+	}
+
+	station, ok := decodeStation(w, r)
+	if !ok {
+		return
+	}
+	station.ID = stationID
+	station.TournamentID = tournamentID
+
+	queue, err := h.stationSvc.UpdateStation(r.Context(), station)
+	if err != nil {
+		writeStationError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toStationQueueResponse(queue))
+}
+
+// Delete removes a station (organizer only). Its match goes back to the queue.
+func (h *StationHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+	stationID, err := strconv.ParseUint(chi.URLParam(r, "stationId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid station ID")
+		return
+	}
+	if !requireOrganizer(w, r, tournamentID, "only the tournament organizer can manage stations") {
+		return
+	}
+
+	queue, err := h.stationSvc.DeleteStation(r.Context(), tournamentID, stationID)
+	if err != nil {
+		writeStationError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toStationQueueResponse(queue))
+}
+
+// Assign puts ready matches on free stations (organizer only). Stations are also
+// assigned whenever a result frees one.
+func (h *StationHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	tournamentID, err := strconv.ParseUint(chi.URLParam(r, "tournamentId"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tournament ID")
+		return
+	}
+	if !requireOrganizer(w, r, tournamentID, "only the tournament organizer can assign stations") {
+		return
+	}
+
+	queue, err := h.stationSvc.AssignStations(r.Context(), tournamentID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.NewEncoder(w).Encode(toStationQueueResponse(queue))
+}
+
+// requireOrganizer checks that the authenticated user organizes the tournament,
+// writing the error response if not.
+func requireOrganizer(w http.ResponseWriter, r *http.Request, tournamentID uint64, forbidden string) bool {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return false
+	}
+
+	isOrganizer, err := verifyOrganizer(tournamentID, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to verify permissions")
+		return false
+	}
+	if !isOrganizer {
+		writeError(w, http.StatusForbidden, forbidden)
+		return false
+	}
+	return true
+}
+
+func decodeStation(w http.ResponseWriter, r *http.Request) (*domain.Station, bool) {
+	var req StationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return nil, false
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return nil, false
+	}
+
+	station := &domain.Station{Name: name, Stream: req.Stream, Active: true}
+	if req.Active != nil {
+		station.Active = *req.Active
+	}
+	return station, true
+}
+
+func writeStationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, repository.ErrStationNotFound), errors.Is(err, service.ErrStationTournament):
+		writeError(w, http.StatusNotFound, "station not found")
+	default:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func toStationQueueResponse(queue *service.StationQueue) *StationQueueResponse {
+	resp := &StationQueueResponse{
+		TournamentID: queue.TournamentID,
+		Stations:     make([]*StationResponse, len(queue.Stations)),
+		Waiting:      make([]*MatchResponse, len(queue.Waiting)),
+	}
+	for i, st := range queue.Stations {
+		resp.Stations[i] = &StationResponse{
+			ID:     st.ID,
+			Name:   st.Name,
+			Stream: st.Stream,
+			Active: st.Active,
+		}
+		if st.MatchID != nil {
+			if m, ok := queue.Matches[*st.MatchID]; ok {
+				resp.Stations[i].Match = toMatchResponse(m)
+			}
+		}
+	}
+	for i, m := range queue.Waiting {
+		resp.Waiting[i] = toMatchResponse(m)
+	}
+	return resp
+}
@@ -14,6 +14,10 @@ import (
 func NewRouter(
 	repo repository.MatchRepository,
 	setRepo repository.SetRepository,
+	slotRepo repository.SlotRepository,
+	stationRepo repository.StationRepository,
+	reportRepo repository.ReportRepository,
+	eventRepo repository.EventRepository,
 	tournamentClient client.TournamentClient,
 	communityClient client.CommunityClient,
 ) chi.Router {
@@ -25,37 +29,69 @@ func NewRouter(
 	r.Use(middleware.SetHeader("Content-Type", "application/json"))
 
 	// Create services
-	bracketSvc := service.NewBracketService(repo)
-	matchSvc := service.NewMatchService(repo, setRepo, tournamentClient, communityClient)
-	forfeitSvc := service.NewForfeitService(repo)
+	bracketSvc := service.NewBracketService(repo, slotRepo, tournamentClient)
+	matchSvc := service.NewMatchService(repo, setRepo, slotRepo, stationRepo, eventRepo, tournamentClient, communityClient)
+	forfeitSvc := service.NewForfeitService(repo, setRepo, stationRepo, eventRepo, tournamentClient)
+	heatSvc := service.NewHeatService(repo, slotRepo, stationRepo, eventRepo, tournamentClient)
+	stationSvc := service.NewStationService(repo, stationRepo, tournamentClient)
+	reportSvc := service.NewReportService(repo, reportRepo, matchSvc, tournamentClient)
 
 	// Create handlers
-	bracketHandler := handlers.NewBracketHandler(bracketSvc, matchSvc, repo, setRepo)
-	matchHandler := handlers.NewMatchHandler(matchSvc, repo, setRepo)
-	forfeitHandler := handlers.NewForfeitHandler(forfeitSvc)
+	bracketHandler := handlers.NewBracketHandler(bracketSvc, matchSvc, heatSvc, repo, setRepo, slotRepo)
+	matchHandler := handlers.NewMatchHandler(matchSvc, heatSvc, forfeitSvc, reportSvc, repo, setRepo, slotRepo, reportRepo)
+	forfeitHandler := handlers.NewForfeitHandler(forfeitSvc, matchSvc)
+	stationHandler := handlers.NewStationHandler(stationSvc)
+	eventHandler := handlers.NewEventHandler(eventRepo)
 
 	// Health check
 	r.Get("/health", handlers.Health)
 
 	// Bracket routes
 	r.Post("/brackets", bracketHandler.Generate)
+	r.Post("/brackets/preview", bracketHandler.Preview)
 	r.Get("/brackets/{tournamentId}", bracketHandler.GetState)
+	r.Get("/brackets/{tournamentId}/standings", bracketHandler.GetStandings)
+	r.Get("/brackets/{tournamentId}/schedule", bracketHandler.GetSchedule)
 	r.Get("/brackets/{tournamentId}/matches", bracketHandler.ListMatches)
+	r.Get("/brackets/{tournamentId}/stations", stationHandler.GetQueue)
+	r.Get("/brackets/{tournamentId}/history", eventHandler.TournamentHistory)
 
 	// Match routes (nested under /brackets)
 	r.Get("/brackets/matches/{id}", matchHandler.Get)
-	r.Post("/brackets/matches/{id}/result", matchHandler.ReportResult)
+	r.Get("/brackets/matches/{id}/history", eventHandler.MatchHistory)
 	r.Post("/brackets/matches/{id}/start", matchHandler.Start)
 
 	// Protected match routes (require auth)
 	r.Group(func(r chi.Router) {
 		r.Use(authmw.Auth)
+		r.Post("/brackets/matches/{id}/result", matchHandler.ReportResult)
+		r.Post("/brackets/matches/{id}/result/confirm", matchHandler.ConfirmResult)
+		r.Post("/brackets/matches/{id}/placements", matchHandler.ReportPlacements)
+		r.Post("/brackets/matches/{id}/call", matchHandler.Call)
+		r.Post("/brackets/matches/{id}/check-in", matchHandler.CheckIn)
 		r.Post("/brackets/matches/{id}/reopen", matchHandler.Reopen)
 		r.Put("/brackets/matches/{id}/result", matchHandler.EditResult)
+		r.Post("/brackets/matches/{id}/double-forfeit", matchHandler.DoubleForfeit)
+
+		r.Post("/brackets/{tournamentId}/next-round", bracketHandler.NextRound)
+		r.Post("/brackets/{tournamentId}/regenerate", bracketHandler.Regenerate)
+		r.Post("/brackets/{tournamentId}/schedule", bracketHandler.Schedule)
+
+		r.Post("/brackets/{tournamentId}/stations", stationHandler.Create)
+		r.Put("/brackets/{tournamentId}/stations/{stationId}", stationHandler.Update)
+		r.Delete("/brackets/{tournamentId}/stations/{stationId}", stationHandler.Delete)
+		r.Post("/brackets/{tournamentId}/stations/assign", stationHandler.Assign)
 	})
 
-	// Forfeit route (internal, called by tournament service)
+	// Forfeit routes (internal, called by tournament service)
 	r.Post("/brackets/forfeit-participant", forfeitHandler.ForfeitParticipant)
+	r.Post("/brackets/disqualify-participant", forfeitHandler.DisqualifyParticipant)
+
+	// Late entry route (internal, called by tournament service)
+	r.Post("/brackets/late-entry", bracketHandler.LateEntry)
+
+	// Substitution route (internal, called by tournament service)
+	r.Post("/brackets/substitute", bracketHandler.Substitute)
 
 	return r
 }
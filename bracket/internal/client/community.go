@@ -12,6 +12,7 @@ import (
 type CommunityClient interface {
 	ProcessMatchElo(ctx context.Context, req ProcessMatchEloRequest) (*ProcessMatchEloResponse, error)
 	GetEloSystem(ctx context.Context, systemID uint64) (*EloSystemResponse, error)
+	VoidMatchElo(ctx context.Context, matchID uint64) error
 }
 
 type ProcessMatchEloRequest struct {
@@ -20,6 +21,11 @@ type ProcessMatchEloRequest struct {
 	TournamentID   uint64 `json:"tournament_id"`
 	WinnerMemberID uint64 `json:"winner_member_id"`
 	LoserMemberID  uint64 `json:"loser_member_id"`
+	Draw           bool   `json:"draw,omitempty"` // Winner and loser are just the two players
+}
+
+type VoidMatchEloRequest struct {
+	MatchID uint64 `json:"match_id"`
 }
 
 type ProcessMatchEloResponse struct {
@@ -115,3 +121,32 @@ func (c *communityClient) GetEloSystem(ctx context.Context, systemID uint64) (*E
 
 	return &system, nil
 }
+
+// VoidMatchElo asks the community service to undo the rating changes of a match whose
+// result was voided. Matches that were never rated are ignored.
+func (c *communityClient) VoidMatchElo(ctx context.Context, matchID uint64) error {
+	url := fmt.Sprintf("%s/internal/elo/void-match", c.baseURL)
+
+	body, err := json.Marshal(VoidMatchEloRequest{MatchID: matchID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call community service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("community service returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
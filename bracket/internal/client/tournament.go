@@ -14,15 +14,77 @@ type TournamentClient interface {
 }
 
 type TournamentResponse struct {
-	ID          uint64  `json:"id"`
-	Slug        string  `json:"slug"`
-	OrganizerID uint64  `json:"organizer_id"`
-	CommunityID *uint64 `json:"community_id,omitempty"`
-	EloSystemID *uint64 `json:"elo_system_id,omitempty"`
-	Name        string  `json:"name"`
-	Status      string  `json:"status"`
+	ID          uint64             `json:"id"`
+	Slug        string             `json:"slug"`
+	OrganizerID uint64             `json:"organizer_id"`
+	CommunityID *uint64            `json:"community_id,omitempty"`
+	EloSystemID *uint64            `json:"elo_system_id,omitempty"`
+	Name        string             `json:"name"`
+	Status      string             `json:"status"`
+	Settings    TournamentSettings `json:"settings"`
+	StartsAt    *time.Time         `json:"starts_at,omitempty"`
+	Stages      []StageResponse    `json:"stages,omitempty"`
 }
 
+// StageResponse is one stage of a multi-stage tournament.
+type StageResponse struct {
+	StageNumber       int    `json:"stage_number"`
+	Format            string `json:"format"`
+	PoolCount         int    `json:"pool_count"`
+	QualifiersPerPool int    `json:"qualifiers_per_pool"`
+}
+
+// TournamentSettings mirrors the per-tournament format options stored by the tournament service.
+type TournamentSettings struct {
+	GrandFinalReset    bool     `json:"grand_final_reset"`
+	ThirdPlaceMatch    bool     `json:"third_place_match"`
+	ConsolationBracket bool     `json:"consolation_bracket"`
+	BracketSizing      string   `json:"bracket_sizing,omitempty"`
+	HeatSize           int      `json:"heat_size,omitempty"`
+	HeatAdvance        int      `json:"heat_advance,omitempty"`
+	SeparateTagsUntil  int      `json:"separate_tags_until_round,omitempty"`
+	Tiebreakers        []string `json:"tiebreakers,omitempty"`
+	SwissRounds        int      `json:"swiss_rounds,omitempty"`
+	ScoringMode        string   `json:"scoring_mode,omitempty"`
+
+	MatchRules           *MatchRules         `json:"match_rules,omitempty"`
+	MatchRuleOverrides   []MatchRuleOverride `json:"match_rule_overrides,omitempty"`
+	Schedule             *ScheduleSettings   `json:"schedule,omitempty"`
+	StreamSeeds          int                 `json:"stream_seeds,omitempty"`
+	CheckInMinutes       int                 `json:"check_in_minutes,omitempty"`
+	ReportConfirmMinutes int                 `json:"report_confirm_minutes,omitempty"`
+}
+
+// MatchRules is the set format matches are played under.
+type MatchRules struct {
+	BestOf        int  `json:"best_of,omitempty"`
+	SetsToWin     int  `json:"sets_to_win,omitempty"`
+	AllowTiedSets bool `json:"allow_tied_sets"`
+}
+
+// MatchRuleOverride replaces the tournament's match rules in one bracket type, one
+// round, or one round of one bracket type.
+type MatchRuleOverride struct {
+	BracketType string `json:"bracket_type,omitempty"`
+	Round       int    `json:"round,omitempty"`
+	MatchRules
+}
+
+// ScheduleSettings is how long matches take and how many can be played at once.
+type ScheduleSettings struct {
+	MatchMinutes  int         `json:"match_minutes,omitempty"`
+	BestOfMinutes map[int]int `json:"best_of_minutes,omitempty"`
+	Setups        int         `json:"setups,omitempty"`
+	RestMinutes   int         `json:"rest_minutes,omitempty"`
+}
+
+// Participant statuses that take a participant out of the tournament
+const (
+	ParticipantWithdrawn    = "withdrawn"
+	ParticipantDisqualified = "disqualified"
+	ParticipantSubstituted  = "substituted"
+)
+
 type ParticipantResponse struct {
 	ID                uint64  `json:"id"`
 	TournamentID      uint64  `json:"tournament_id"`
@@ -30,6 +92,18 @@ type ParticipantResponse struct {
 	CommunityMemberID *uint64 `json:"community_member_id,omitempty"`
 	DisplayName       string  `json:"display_name"`
 	Status            string  `json:"status"`
+
+	// Members is the roster of a team participant (team tournaments only)
+	Members []TeamMemberResponse `json:"members,omitempty"`
+}
+
+// TeamMemberResponse is one player on a team participant's roster.
+type TeamMemberResponse struct {
+	ID                uint64  `json:"id"`
+	UserID            *uint64 `json:"user_id,omitempty"`
+	CommunityMemberID *uint64 `json:"community_member_id,omitempty"`
+	DisplayName       string  `json:"display_name"`
+	Substitute        bool    `json:"substitute"`
 }
 
 type tournamentClient struct {
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// MatchEventType is the kind of result change recorded in the audit log
+type MatchEventType string
+
+const (
+	EventReport  MatchEventType = "report"  // A result was recorded
+	EventEdit    MatchEventType = "edit"    // A completed match's result was changed
+	EventReopen  MatchEventType = "reopen"  // A completed match's result was cleared
+	EventForfeit MatchEventType = "forfeit" // A match was forfeited by one or both participants
+	EventCascade MatchEventType = "cascade" // A downstream match was reopened by a change upstream
+)
+
+// MatchSnapshot is a match's result at one point in time.
+type MatchSnapshot struct {
+	Completed       bool        `json:"completed"`
+	WinnerID        *uint64     `json:"winner_id,omitempty"`
+	ForfeitWinnerID *uint64     `json:"forfeit_winner_id,omitempty"`
+	Draw            bool        `json:"draw,omitempty"`
+	Sets            []SetScore  `json:"sets"`
+	Placements      []Placement `json:"placements,omitempty"` // Heats only
+}
+
+// MatchEvent is one change to a match's result, with who made it and why.
+type MatchEvent struct {
+	ID           uint64
+	TournamentID uint64
+	MatchID      uint64
+	Type         MatchEventType
+	UserID       *uint64 // Who made the change, nil if it was made by a service or a timer
+	Reason       string
+	Before       MatchSnapshot
+	After        MatchSnapshot
+	CreatedAt    time.Time
+}
@@ -5,9 +5,32 @@ import "time"
 type BracketType string
 
 const (
-	BracketWinners    BracketType = "winners"
-	BracketLosers     BracketType = "losers"
-	BracketGrandFinal BracketType = "grand_final"
+	BracketWinners     BracketType = "winners"
+	BracketLosers      BracketType = "losers"
+	BracketGrandFinal  BracketType = "grand_final"
+	BracketRoundRobin  BracketType = "round_robin"
+	BracketSwiss       BracketType = "swiss"
+	BracketThirdPlace  BracketType = "third_place"
+	BracketConsolation BracketType = "consolation"
+	BracketHeat        BracketType = "heat"
+)
+
+// ScoringMode is how a match's result is reported
+type ScoringMode string
+
+const (
+	ScoringSets        ScoringMode = "sets"          // Set by set scores; whoever wins more sets wins
+	ScoringScore       ScoringMode = "score"         // A single final score, reported as one set
+	ScoringWinDrawLoss ScoringMode = "win_draw_loss" // Only the outcome, with no scores
+)
+
+// MatchOutcome is the result of a win/draw/loss match
+type MatchOutcome string
+
+const (
+	OutcomeParticipant1 MatchOutcome = "participant1"
+	OutcomeParticipant2 MatchOutcome = "participant2"
+	OutcomeDraw         MatchOutcome = "draw"
 )
 
 type MatchStatus string
@@ -15,13 +38,17 @@ type MatchStatus string
 const (
 	MatchPending    MatchStatus = "pending"
 	MatchReady      MatchStatus = "ready"
+	MatchCalled     MatchStatus = "called" // Waiting for both participants to check in
 	MatchInProgress MatchStatus = "in_progress"
+	MatchDisputed   MatchStatus = "disputed" // The participants reported different results
 	MatchCompleted  MatchStatus = "completed"
 )
 
 type Match struct {
 	ID               uint64
 	TournamentID     uint64
+	Stage            int // 1-based; single-stage tournaments only have stage 1
+	Pool             int // 1-based pool within a qualifying stage, 0 if the stage has no pools
 	BracketType      BracketType
 	Round            int
 	Position         int
@@ -32,15 +59,42 @@ type Match struct {
 	Seed1            *int
 	Seed2            *int
 	WinnerID         *uint64
-	Sets             []Set // Set-based scoring (replaces simple scores)
+	Sets             []Set         // Set-based scoring (replaces simple scores)
+	Slots            []Slot        // Free-for-all heats only: one slot per participant
+	Reports          []MatchReport // Results reported by participants and not yet confirmed
+	AdvanceCount     int           // Free-for-all heats only: top finishers that advance (0 for the final)
+	Format           MatchFormat
 	Status           MatchStatus
 	ScheduledAt      *time.Time
 	CompletedAt      *time.Time
 	NextMatchID      *uint64
 	LoserMatchID     *uint64
 	ForfeitWinnerID  *uint64 // Non-nil if match was won by forfeit (opponent withdrew)
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	Draw             bool    // Completed with no winner because the result was a draw
+
+	// Called matches only: participants who have not checked in by the deadline forfeit
+	CheckInDeadline       *time.Time
+	Participant1CheckedIn bool
+	Participant2CheckedIn bool
+
+	Participant1Disqualified bool
+	Participant2Disqualified bool
+
+	// Participants who withdrew while waiting here forfeit to whoever is placed opposite
+	Participant1Withdrawn bool
+	Participant2Withdrawn bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MatchFormat is the format a match is played under. The zero value places no limits:
+// any number of sets is accepted and whoever wins more of them wins.
+type MatchFormat struct {
+	BestOf        int  // Most sets that can be played, 0 for no limit
+	SetsToWin     int  // Sets that clinch the match, 0 for no limit
+	AllowTiedSets bool // A tied set counts for neither side instead of being rejected
+	Scoring       ScoringMode
 }
 
 // Set represents a single set within a match
@@ -54,6 +108,25 @@ type Set struct {
 	UpdatedAt         time.Time
 }
 
+// Slot is one participant's seat in a free-for-all heat
+type Slot struct {
+	ID              uint64
+	MatchID         uint64
+	SlotNumber      int
+	ParticipantID   *uint64
+	ParticipantName *string
+	Seed            *int
+	Placement       *int // Finishing position once the heat is reported, 1 = first
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Placement is a participant's finishing position in a heat, for API requests
+type Placement struct {
+	ParticipantID uint64 `json:"participant_id"`
+	Placement     int    `json:"placement"`
+}
+
 // SetScore represents set scores for API requests (without database fields)
 type SetScore struct {
 	SetNumber         int `json:"set_number"`
@@ -62,7 +135,22 @@ type SetScore struct {
 }
 
 // MatchResult contains the sets data for reporting a match result
-// Winner is computed from sets (whoever wins the most sets)
+// Winner is computed from sets (whoever wins the most sets), or taken from the outcome
+// in win/draw/loss matches
 type MatchResult struct {
-	Sets []SetScore
+	Sets    []SetScore
+	Outcome MatchOutcome // Win/draw/loss matches only
+}
+
+// MatchReport is a result reported by one of a match's participants. It completes the
+// match once the opponent confirms it or the confirm deadline passes.
+type MatchReport struct {
+	ID              uint64
+	MatchID         uint64
+	ParticipantID   uint64 // The participant who reported it
+	UserID          uint64 // The user who reported it for them
+	Result          MatchResult
+	ConfirmDeadline time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
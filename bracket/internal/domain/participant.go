@@ -6,4 +6,5 @@ type Participant struct {
 	ID   uint64
 	Name string
 	Seed int
+	Tag  string // Region, club, etc.; participants sharing a tag can be kept apart early on
 }
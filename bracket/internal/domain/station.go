@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// Station is a numbered setup, stream or court that a tournament's matches are
+// played on. A station holds at most one match at a time.
+type Station struct {
+	ID           uint64
+	TournamentID uint64
+	Name         string
+	Stream       bool    // Matches played here are streamed
+	Active       bool    // Inactive stations are not given matches
+	MatchID      *uint64 // Match currently assigned here, nil while the station is free
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
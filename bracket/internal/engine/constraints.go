@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// SeedingConflict is a pair of participants sharing a tag who could not be kept apart
+// until the configured round.
+type SeedingConflict struct {
+	Tag            string
+	Participant1ID uint64
+	Participant2ID uint64
+	Round          int // Earliest round the pair can meet
+}
+
+// SeparateTags reseeds participants so that those sharing a tag cannot meet in an
+// elimination bracket before untilRound. Participants only trade seeds within their
+// seed tier (1, 2, 3-4, 5-8, 9-16, ...), so every seed keeps the strength of opponents
+// it was due. Tiers are settled from the top, swapping seeds within a tier for as long
+// as a swap removes a clash with the tiers above or within the tier. Pairs that still
+// meet too early are returned as conflicts. Participants without a tag are never
+// constrained. The returned participants are reseeded 1..N, ordered by their new seed.
+func SeparateTags(participants []domain.Participant, untilRound int) ([]domain.Participant, []SeedingConflict) {
+	sorted := make([]domain.Participant, len(participants))
+	copy(sorted, participants)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Seed < sorted[j].Seed
+	})
+	for i := range sorted {
+		sorted[i].Seed = i + 1
+	}
+	if untilRound < 2 || len(sorted) < 2 {
+		return sorted, nil
+	}
+
+	slots := seedSlots(CalculateBracketSize(len(sorted)))
+	clash := func(a, b domain.Participant) bool {
+		return a.Tag != "" && a.Tag == b.Tag && meetingRound(slots[a.Seed], slots[b.Seed]) < untilRound
+	}
+
+	var placed []domain.Participant
+	for start := 0; start < len(sorted); start = tierEnd(start) {
+		tier := sorted[start:min(tierEnd(start), len(sorted))]
+
+		// Clashes involving the tier, with the tiers above and within itself
+		cost := func() int {
+			count := 0
+			for i, p := range tier {
+				for _, other := range placed {
+					if clash(p, other) {
+						count++
+					}
+				}
+				for _, other := range tier[i+1:] {
+					if clash(p, other) {
+						count++
+					}
+				}
+			}
+			return count
+		}
+
+		// Swap seeds within the tier while any swap removes a clash
+		best := cost()
+		for improved := best > 0; improved; {
+			improved = false
+			for i := range tier {
+				for j := i + 1; j < len(tier); j++ {
+					tier[i].Seed, tier[j].Seed = tier[j].Seed, tier[i].Seed
+					if c := cost(); c < best {
+						best, improved = c, true
+						continue
+					}
+					tier[i].Seed, tier[j].Seed = tier[j].Seed, tier[i].Seed
+				}
+			}
+		}
+		placed = append(placed, tier...)
+	}
+	sort.Slice(placed, func(i, j int) bool {
+		return placed[i].Seed < placed[j].Seed
+	})
+
+	var conflicts []SeedingConflict
+	for i, p := range placed {
+		for _, other := range placed[i+1:] {
+			if clash(p, other) {
+				conflicts = append(conflicts, SeedingConflict{
+					Tag:            p.Tag,
+					Participant1ID: p.ID,
+					Participant2ID: other.ID,
+					Round:          meetingRound(slots[p.Seed], slots[other.Seed]),
+				})
+			}
+		}
+	}
+
+	return placed, conflicts
+}
+
+// seedSlots returns each seed's line in the bracket (0-indexed, top to bottom) under
+// the standard pairings.
+func seedSlots(bracketSize int) map[int]int {
+	slots := make(map[int]int, bracketSize)
+	for i, pair := range GenerateSeedPairings(bracketSize) {
+		slots[pair[0]] = i * 2
+		slots[pair[1]] = i*2 + 1
+	}
+	return slots
+}
+
+// meetingRound returns the round in which the winners of two bracket lines meet.
+func meetingRound(slotA, slotB int) int {
+	return bits.Len(uint(slotA ^ slotB))
+}
+
+// tierEnd returns the index just past the seed tier that starts at index start
+// (seed start+1): the tiers are 1, 2, 3-4, 5-8, 9-16 and so on.
+func tierEnd(start int) int {
+	if start == 0 {
+		return 1
+	}
+	return start * 2
+}
@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestSeparateTags_SwapsWithinTier(t *testing.T) {
+	participants := makeParticipants(8)
+	participants[0].Tag = "north" // Seed 1
+	participants[7].Tag = "north" // Seed 8, seed 1's first round opponent
+
+	seeded, conflicts := SeparateTags(participants, 2)
+	if len(conflicts) != 0 {
+		t.Fatalf("expected no conflicts, got %v", conflicts)
+	}
+
+	seeds := make(map[uint64]int)
+	for _, p := range seeded {
+		seeds[p.ID] = p.Seed
+	}
+	if seeds[1] != 1 {
+		t.Errorf("seed 1 should not move, got seed %d", seeds[1])
+	}
+	if seeds[8] < 5 || seeds[8] == 8 {
+		t.Errorf("participant 8 should move to another seed in the 5-8 tier, got seed %d", seeds[8])
+	}
+
+	// The bracket is still a valid seeding: every seed used once
+	used := make(map[int]bool)
+	for _, p := range seeded {
+		used[p.Seed] = true
+	}
+	if len(used) != 8 {
+		t.Errorf("expected 8 distinct seeds, got %d", len(used))
+	}
+}
+
+func TestSeparateTags_ReportsUnsatisfiable(t *testing.T) {
+	participants := makeParticipants(4)
+	for i := range participants {
+		participants[i].Tag = "club"
+	}
+
+	_, conflicts := SeparateTags(participants, 2)
+	if len(conflicts) != 2 {
+		t.Fatalf("expected both first round matches reported, got %d conflicts", len(conflicts))
+	}
+	for _, c := range conflicts {
+		if c.Round != 1 || c.Tag != "club" {
+			t.Errorf("unexpected conflict %+v", c)
+		}
+	}
+}
+
+func TestSeparateTags_KeepsApartUntilRound(t *testing.T) {
+	// Seeds 1-4 share a tag; keeping them apart until the semifinals puts one in each quarter
+	participants := makeParticipants(16)
+	for i := 0; i < 4; i++ {
+		participants[i].Tag = "east"
+	}
+	participants[4].Tag = "east" // Seed 5 lands in a quarter with one of them
+
+	seeded, conflicts := SeparateTags(participants, 3)
+	if len(conflicts) != 1 || conflicts[0].Round != 2 {
+		t.Fatalf("expected a single round 2 conflict, got %+v", conflicts)
+	}
+
+	byID := make(map[uint64]domain.Participant)
+	for _, p := range seeded {
+		byID[p.ID] = p
+	}
+	if byID[5].Seed < 5 || byID[5].Seed > 8 {
+		t.Errorf("participant 5 should stay in the 5-8 tier, got seed %d", byID[5].Seed)
+	}
+}
+
+func TestSeparateTags_Disabled(t *testing.T) {
+	participants := makeParticipants(4)
+	participants[0].Tag = "a"
+	participants[3].Tag = "a"
+
+	seeded, conflicts := SeparateTags(participants, 0)
+	if conflicts != nil {
+		t.Errorf("expected no conflicts when disabled, got %v", conflicts)
+	}
+	for i, p := range seeded {
+		if p.Seed != i+1 || p.ID != participants[i].ID {
+			t.Errorf("expected seeding unchanged, got %+v at %d", p, i)
+		}
+	}
+}
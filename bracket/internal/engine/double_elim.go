@@ -0,0 +1,192 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// DoubleElimination generates a double elimination bracket for the given participants.
+// The winners bracket is identical to SingleElimination. Losers drop into a losers bracket
+// with 2*(winnersRounds-1) rounds, and the two bracket winners meet in a grand final.
+// Links (NextMatchID/LoserMatchID) are set by LinkDoubleElimination once IDs are assigned.
+func DoubleElimination(tournamentID uint64, participants []domain.Participant) ([]*domain.Match, error) {
+	matches, err := SingleElimination(tournamentID, participants)
+	if err != nil {
+		return nil, err
+	}
+
+	bracketSize := CalculateBracketSize(len(participants))
+
+	// Losers bracket placeholders
+	for round := 1; round <= LosersRounds(bracketSize); round++ {
+		for i := range LosersMatchesInRound(bracketSize, round) {
+			matches = append(matches, &domain.Match{
+				TournamentID: tournamentID,
+				BracketType:  domain.BracketLosers,
+				Round:        round,
+				Position:     i + 1,
+				Status:       domain.MatchPending,
+			})
+		}
+	}
+
+	// Grand final: winners bracket champion (slot 1) vs losers bracket champion (slot 2)
+	matches = append(matches, &domain.Match{
+		TournamentID: tournamentID,
+		BracketType:  domain.BracketGrandFinal,
+		Round:        1,
+		Position:     1,
+		Status:       domain.MatchPending,
+	})
+
+	return matches, nil
+}
+
+// LosersRounds returns the number of losers bracket rounds for a bracket of given size.
+// Each winners round after the first feeds a "drop-in" round, and each drop-in round
+// is followed by a round that halves the field.
+func LosersRounds(bracketSize int) int {
+	winnersRounds := TotalRounds(bracketSize)
+	if winnersRounds < 2 {
+		return 0
+	}
+	return 2 * (winnersRounds - 1)
+}
+
+// LosersMatchesInRound returns the number of matches in a losers bracket round (1-indexed).
+// Rounds come in pairs of equal size: 1 and 2 have bracketSize/4 matches, 3 and 4 have
+// bracketSize/8, and so on.
+func LosersMatchesInRound(bracketSize, round int) int {
+	if round < 1 || round > LosersRounds(bracketSize) {
+		return 0
+	}
+	matches := bracketSize / 4
+	for i := 1; i < (round+1)/2; i++ {
+		matches /= 2
+	}
+	return matches
+}
+
+// LinkDoubleElimination sets NextMatchID and LoserMatchID for a double elimination bracket.
+// Must be called after matches have been saved and have IDs assigned.
+//
+//   - Winners round 1 losers fill losers round 1 in pairs.
+//   - Winners round k (k >= 2) losers drop into losers round 2(k-1), taking slot 2.
+//     Every other drop-in round is reversed to delay rematches.
+//   - Odd losers rounds feed the next round one-to-one (slot 1); even rounds halve.
+//   - The winners final and the losers final feed the grand final.
+func LinkDoubleElimination(matches []*domain.Match) {
+	winners := make(map[int][]*domain.Match)
+	losers := make(map[int][]*domain.Match)
+	var grandFinal *domain.Match
+
+	for _, m := range matches {
+		switch m.BracketType {
+		case domain.BracketWinners:
+			winners[m.Round] = append(winners[m.Round], m)
+		case domain.BracketLosers:
+			losers[m.Round] = append(losers[m.Round], m)
+		case domain.BracketGrandFinal:
+			if m.Round == 1 {
+				grandFinal = m
+			}
+		}
+	}
+	for _, byRound := range []map[int][]*domain.Match{winners, losers} {
+		for round := range byRound {
+			sort.Slice(byRound[round], func(i, j int) bool {
+				return byRound[round][i].Position < byRound[round][j].Position
+			})
+		}
+	}
+
+	winnersRounds := len(winners)
+	losersRounds := len(losers)
+
+	// Winners bracket: advance within the bracket, final goes to the grand final
+	for round := 1; round <= winnersRounds; round++ {
+		for i, m := range winners[round] {
+			if round < winnersRounds {
+				m.NextMatchID = matchIDPtr(winners[round+1][i/2])
+			} else if grandFinal != nil {
+				m.NextMatchID = matchIDPtr(grandFinal)
+			}
+
+			switch {
+			case losersRounds == 0:
+				// Two-player bracket: the loser goes straight to the grand final
+				if grandFinal != nil {
+					m.LoserMatchID = matchIDPtr(grandFinal)
+				}
+			case round == 1:
+				m.LoserMatchID = matchIDPtr(losers[1][i/2])
+			default:
+				dropRound := losers[2*(round-1)]
+				idx := i
+				if round%2 == 0 {
+					idx = len(dropRound) - 1 - i
+				}
+				m.LoserMatchID = matchIDPtr(dropRound[idx])
+			}
+		}
+	}
+
+	// Losers bracket: odd rounds feed one-to-one, even rounds halve the field
+	for round := 1; round <= losersRounds; round++ {
+		for i, m := range losers[round] {
+			switch {
+			case round == losersRounds:
+				if grandFinal != nil {
+					m.NextMatchID = matchIDPtr(grandFinal)
+				}
+			case round%2 == 1:
+				m.NextMatchID = matchIDPtr(losers[round+1][i])
+			default:
+				m.NextMatchID = matchIDPtr(losers[round+1][i/2])
+			}
+		}
+	}
+}
+
+// WinnerSlot returns the slot (1 or 2) the winner of from takes in next.
+func WinnerSlot(from, next *domain.Match) int {
+	switch {
+	case next.BracketType == domain.BracketGrandFinal:
+		// Winners bracket champion is always slot 1 in the grand final
+		if from.BracketType == domain.BracketWinners {
+			return 1
+		}
+		return 2
+	case from.BracketType == domain.BracketLosers && from.Round%2 == 1:
+		// Odd losers rounds feed the drop-in round one-to-one; slot 2 is for the dropping loser
+		return 1
+	}
+	return slotForPosition(from.Position)
+}
+
+// LoserSlot returns the slot (1 or 2) the loser of from takes in target.
+func LoserSlot(from, target *domain.Match) int {
+	switch {
+	case target.BracketType == domain.BracketGrandFinal:
+		return 2
+	case target.BracketType == domain.BracketLosers && target.Round%2 == 0:
+		return 2
+	}
+	return slotForPosition(from.Position)
+}
+
+// slotForPosition maps a match position to the slot its winner takes when two
+// matches feed one: odd positions go to slot 1, even positions go to slot 2.
+func slotForPosition(position int) int {
+	if position%2 == 0 {
+		return 2
+	}
+	return 1
+}
+
+// matchIDPtr returns a pointer to a copy of the match's ID for use as a link.
+func matchIDPtr(m *domain.Match) *uint64 {
+	id := m.ID
+	return &id
+}
@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// assignIDs gives generated matches sequential IDs, as CreateBatch would.
+func assignIDs(matches []*domain.Match) map[uint64]*domain.Match {
+	byID := make(map[uint64]*domain.Match, len(matches))
+	for i, m := range matches {
+		m.ID = uint64(i + 1)
+		byID[m.ID] = m
+	}
+	return byID
+}
+
+func TestLosersRounds(t *testing.T) {
+	tests := []struct {
+		bracketSize int
+		want        int
+	}{
+		{2, 0},
+		{4, 2},
+		{8, 4},
+		{16, 6},
+		{32, 8},
+	}
+
+	for _, tt := range tests {
+		got := LosersRounds(tt.bracketSize)
+		if got != tt.want {
+			t.Errorf("LosersRounds(%d) = %d, want %d", tt.bracketSize, got, tt.want)
+		}
+	}
+}
+
+func TestLosersMatchesInRound(t *testing.T) {
+	tests := []struct {
+		bracketSize int
+		round       int
+		want        int
+	}{
+		{8, 1, 2},
+		{8, 2, 2},
+		{8, 3, 1},
+		{8, 4, 1},
+		{16, 1, 4},
+		{16, 2, 4},
+		{16, 3, 2},
+		{16, 4, 2},
+		{16, 5, 1},
+		{16, 6, 1},
+		{8, 0, 0}, // invalid round
+		{8, 5, 0}, // beyond last round
+	}
+
+	for _, tt := range tests {
+		got := LosersMatchesInRound(tt.bracketSize, tt.round)
+		if got != tt.want {
+			t.Errorf("LosersMatchesInRound(%d, %d) = %d, want %d", tt.bracketSize, tt.round, got, tt.want)
+		}
+	}
+}
+
+func TestDoubleElimination_MatchCounts(t *testing.T) {
+	tests := []struct {
+		participants int
+		winners      int
+		losers       int
+	}{
+		{2, 1, 0},
+		{4, 3, 2},
+		{5, 7, 6},
+		{8, 7, 6},
+		{16, 15, 14},
+	}
+
+	for _, tt := range tests {
+		matches, err := DoubleElimination(1, makeParticipants(tt.participants))
+		if err != nil {
+			t.Fatalf("unexpected error for %d participants: %v", tt.participants, err)
+		}
+
+		counts := make(map[domain.BracketType]int)
+		for _, m := range matches {
+			counts[m.BracketType]++
+		}
+
+		if counts[domain.BracketWinners] != tt.winners {
+			t.Errorf("%d participants: expected %d winners matches, got %d", tt.participants, tt.winners, counts[domain.BracketWinners])
+		}
+		if counts[domain.BracketLosers] != tt.losers {
+			t.Errorf("%d participants: expected %d losers matches, got %d", tt.participants, tt.losers, counts[domain.BracketLosers])
+		}
+		if counts[domain.BracketGrandFinal] != 1 {
+			t.Errorf("%d participants: expected 1 grand final, got %d", tt.participants, counts[domain.BracketGrandFinal])
+		}
+	}
+}
+
+func TestDoubleElimination_MinimumParticipants(t *testing.T) {
+	_, err := DoubleElimination(1, makeParticipants(1))
+	if err == nil {
+		t.Error("expected error for 1 participant")
+	}
+}
+
+func TestLinkDoubleElimination_EightParticipants(t *testing.T) {
+	matches, _ := DoubleElimination(1, makeParticipants(8))
+	byID := assignIDs(matches)
+	LinkDoubleElimination(matches)
+
+	for _, m := range matches {
+		switch m.BracketType {
+		case domain.BracketWinners:
+			if m.NextMatchID == nil {
+				t.Errorf("winners R%d P%d has no next match", m.Round, m.Position)
+			}
+			if m.LoserMatchID == nil {
+				t.Fatalf("winners R%d P%d has no loser match", m.Round, m.Position)
+			}
+			target := byID[*m.LoserMatchID]
+			if target.BracketType != domain.BracketLosers {
+				t.Errorf("winners R%d P%d loser goes to %s, want losers", m.Round, m.Position, target.BracketType)
+			}
+			wantRound := 1
+			if m.Round > 1 {
+				wantRound = 2 * (m.Round - 1)
+			}
+			if target.Round != wantRound {
+				t.Errorf("winners R%d P%d loser goes to losers R%d, want R%d", m.Round, m.Position, target.Round, wantRound)
+			}
+		case domain.BracketLosers:
+			if m.NextMatchID == nil {
+				t.Errorf("losers R%d P%d has no next match", m.Round, m.Position)
+			}
+			if m.LoserMatchID != nil {
+				t.Errorf("losers R%d P%d should not have a loser match", m.Round, m.Position)
+			}
+		case domain.BracketGrandFinal:
+			if m.NextMatchID != nil {
+				t.Error("grand final should not have a next match")
+			}
+		}
+	}
+
+	// Each losers bracket slot is fed by exactly one match
+	fed := make(map[[2]uint64]int)
+	for _, m := range matches {
+		if m.NextMatchID != nil {
+			next := byID[*m.NextMatchID]
+			fed[[2]uint64{next.ID, uint64(WinnerSlot(m, next))}]++
+		}
+		if m.LoserMatchID != nil {
+			target := byID[*m.LoserMatchID]
+			fed[[2]uint64{target.ID, uint64(LoserSlot(m, target))}]++
+		}
+	}
+	for _, m := range matches {
+		if m.BracketType == domain.BracketWinners && m.Round == 1 {
+			continue
+		}
+		for slot := uint64(1); slot <= 2; slot++ {
+			if n := fed[[2]uint64{m.ID, slot}]; n != 1 {
+				t.Errorf("%s R%d P%d slot %d is fed by %d matches, want 1", m.BracketType, m.Round, m.Position, slot, n)
+			}
+		}
+	}
+}
+
+func TestLinkDoubleElimination_TwoParticipants(t *testing.T) {
+	matches, _ := DoubleElimination(1, makeParticipants(2))
+	byID := assignIDs(matches)
+	LinkDoubleElimination(matches)
+
+	final := matches[0]
+	if final.NextMatchID == nil || byID[*final.NextMatchID].BracketType != domain.BracketGrandFinal {
+		t.Error("winners final should feed the grand final")
+	}
+	if final.LoserMatchID == nil || byID[*final.LoserMatchID].BracketType != domain.BracketGrandFinal {
+		t.Error("with no losers bracket the loser should go to the grand final")
+	}
+}
+
+func TestWinnerSlot(t *testing.T) {
+	tests := []struct {
+		name string
+		from domain.Match
+		next domain.Match
+		want int
+	}{
+		{"winners odd position", domain.Match{BracketType: domain.BracketWinners, Round: 1, Position: 3}, domain.Match{BracketType: domain.BracketWinners}, 1},
+		{"winners even position", domain.Match{BracketType: domain.BracketWinners, Round: 1, Position: 2}, domain.Match{BracketType: domain.BracketWinners}, 2},
+		{"winners final to grand final", domain.Match{BracketType: domain.BracketWinners, Round: 3, Position: 1}, domain.Match{BracketType: domain.BracketGrandFinal}, 1},
+		{"losers final to grand final", domain.Match{BracketType: domain.BracketLosers, Round: 4, Position: 1}, domain.Match{BracketType: domain.BracketGrandFinal}, 2},
+		{"losers odd round", domain.Match{BracketType: domain.BracketLosers, Round: 1, Position: 2}, domain.Match{BracketType: domain.BracketLosers}, 1},
+		{"losers even round", domain.Match{BracketType: domain.BracketLosers, Round: 2, Position: 2}, domain.Match{BracketType: domain.BracketLosers}, 2},
+	}
+
+	for _, tt := range tests {
+		if got := WinnerSlot(&tt.from, &tt.next); got != tt.want {
+			t.Errorf("%s: WinnerSlot = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGetBracketState_DoubleElimChampion(t *testing.T) {
+	matches, _ := DoubleElimination(1, makeParticipants(4))
+
+	// Losers bracket has more rounds than the winners bracket; its final must not
+	// be mistaken for the deciding match
+	winner := uint64(3)
+	for _, m := range matches {
+		if m.BracketType == domain.BracketLosers && m.Round == 2 {
+			m.WinnerID = &winner
+		}
+	}
+
+	state := GetBracketState(1, matches)
+	if state.Format != FormatDoubleElim {
+		t.Errorf("expected format double_elimination, got %s", state.Format)
+	}
+	if state.IsComplete {
+		t.Error("bracket should not be complete before the grand final")
+	}
+
+	for _, m := range matches {
+		if m.BracketType == domain.BracketGrandFinal {
+			m.WinnerID = &winner
+		}
+	}
+	state = GetBracketState(1, matches)
+	if !state.IsComplete || state.ChampionID == nil || *state.ChampionID != winner {
+		t.Error("expected grand final winner to be champion")
+	}
+}
@@ -0,0 +1,175 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+const (
+	// DefaultHeatSize is the number of participants per heat when none is configured.
+	DefaultHeatSize = 4
+	// DefaultHeatAdvance is the number of finishers per heat that advance when none is configured.
+	DefaultHeatAdvance = 2
+)
+
+// FreeForAll generates a heat-based advancement bracket: participants race in heats
+// of up to heatSize, and the top advance finishers of each heat move on to a heat in
+// the next round until a single final heat remains. Every next round heat is filled
+// by heatSize/advance heats, so advance must divide heatSize.
+//
+// Participants are spread over the first round heats with a snake draft, and the heats
+// are ordered so the top seeds can only meet as late as possible. A first round heat
+// with no more participants than advance has nobody to eliminate and is completed at
+// once, its participants placed by seed.
+func FreeForAll(tournamentID uint64, participants []domain.Participant, heatSize, advance int) ([]*domain.Match, error) {
+	if len(participants) < 2 {
+		return nil, fmt.Errorf("need at least 2 participants, got %d", len(participants))
+	}
+	if heatSize < 2 {
+		return nil, fmt.Errorf("heat size must be at least 2, got %d", heatSize)
+	}
+	if advance < 1 || advance >= heatSize || heatSize%advance != 0 {
+		return nil, fmt.Errorf("advancing count %d must be less than and divide the heat size %d", advance, heatSize)
+	}
+
+	// Heats feeding each next round heat
+	fanIn := heatSize / advance
+
+	// Fewest rounds whose first round has room for everyone
+	heats, rounds := 1, 1
+	for heats*heatSize < len(participants) {
+		heats *= fanIn
+		rounds++
+	}
+
+	var matches []*domain.Match
+
+	// First round heats, seed-balanced
+	for i, pool := range AssignPools(participants, heats) {
+		heat := &domain.Match{
+			TournamentID: tournamentID,
+			BracketType:  domain.BracketHeat,
+			Round:        1,
+			Position:     spreadPosition(i, fanIn, rounds-1) + 1,
+			Status:       domain.MatchReady,
+		}
+		if rounds > 1 {
+			heat.AdvanceCount = advance
+		}
+		for j := range pool {
+			p := &pool[j]
+			heat.Slots = append(heat.Slots, domain.Slot{
+				SlotNumber:      j + 1,
+				ParticipantID:   &p.ID,
+				ParticipantName: &p.Name,
+				Seed:            &p.Seed,
+			})
+		}
+		if rounds > 1 && len(pool) <= advance {
+			processHeatBye(heat)
+		}
+		matches = append(matches, heat)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Position < matches[j].Position
+	})
+
+	// Later round heats, filled as earlier heats finish
+	for round := 2; round <= rounds; round++ {
+		heats /= fanIn
+		for i := range heats {
+			heat := &domain.Match{
+				TournamentID: tournamentID,
+				BracketType:  domain.BracketHeat,
+				Round:        round,
+				Position:     i + 1,
+				Status:       domain.MatchPending,
+			}
+			if round < rounds {
+				heat.AdvanceCount = advance
+			}
+			for slot := 1; slot <= heatSize; slot++ {
+				heat.Slots = append(heat.Slots, domain.Slot{SlotNumber: slot})
+			}
+			matches = append(matches, heat)
+		}
+	}
+
+	return matches, nil
+}
+
+// spreadPosition orders first round heats by reversing the digits of the heat index
+// in base fanIn, so consecutive seeds land in different branches of the bracket (the
+// same idea as GenerateSeedPairings keeping seeds 1 and 2 apart until the final).
+func spreadPosition(index, fanIn, digits int) int {
+	position := 0
+	for range digits {
+		position = position*fanIn + index%fanIn
+		index /= fanIn
+	}
+	return position
+}
+
+// processHeatBye completes a heat that has nobody to eliminate, placing its
+// participants by seed.
+func processHeatBye(heat *domain.Match) {
+	order := make([]int, len(heat.Slots))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return *heat.Slots[order[i]].Seed < *heat.Slots[order[j]].Seed
+	})
+
+	for place, i := range order {
+		placement := place + 1
+		heat.Slots[i].Placement = &placement
+	}
+	heat.WinnerID = heat.Slots[order[0]].ParticipantID
+	heat.Status = domain.MatchCompleted
+}
+
+// LinkHeats sets NextMatchID for every heat but the final. Must be called after
+// matches have been saved and have IDs assigned.
+func LinkHeats(matches []*domain.Match) {
+	byRound := make(map[int][]*domain.Match)
+	maxRound := 0
+	for _, m := range matches {
+		byRound[m.Round] = append(byRound[m.Round], m)
+		maxRound = max(maxRound, m.Round)
+	}
+	for round := range byRound {
+		sort.Slice(byRound[round], func(i, j int) bool {
+			return byRound[round][i].Position < byRound[round][j].Position
+		})
+	}
+
+	for round := 1; round < maxRound; round++ {
+		fanIn := len(byRound[round]) / len(byRound[round+1])
+		for _, heat := range byRound[round] {
+			heat.NextMatchID = matchIDPtr(byRound[round+1][(heat.Position-1)/fanIn])
+		}
+	}
+}
+
+// HeatSlot returns the slot in the next heat taken by the finisher with the given
+// placement in from. Each feeding heat fills its own block of AdvanceCount slots.
+func HeatSlot(from *domain.Match, nextSlots, placement int) int {
+	fanIn := nextSlots / from.AdvanceCount
+	return ((from.Position-1)%fanIn)*from.AdvanceCount + placement
+}
+
+// IsFreeForAll reports whether the matches are free-for-all heats.
+func IsFreeForAll(matches []*domain.Match) bool {
+	if len(matches) == 0 {
+		return false
+	}
+	for _, m := range matches {
+		if m.BracketType != domain.BracketHeat {
+			return false
+		}
+	}
+	return true
+}
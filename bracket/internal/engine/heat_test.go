@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestFreeForAll_InvalidHeatSettings(t *testing.T) {
+	tests := []struct {
+		heatSize, advance int
+	}{
+		{1, 1},
+		{4, 0},
+		{4, 4},
+		{6, 4},
+	}
+
+	for _, tt := range tests {
+		if _, err := FreeForAll(1, makeParticipants(8), tt.heatSize, tt.advance); err == nil {
+			t.Errorf("FreeForAll(heat %d, advance %d): expected error", tt.heatSize, tt.advance)
+		}
+	}
+}
+
+func TestFreeForAll_EightParticipants(t *testing.T) {
+	matches, err := FreeForAll(1, makeParticipants(8), 4, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 3 {
+		t.Fatalf("expected 2 heats and a final, got %d matches", len(matches))
+	}
+
+	byID := assignIDs(matches)
+	LinkHeats(matches)
+
+	for _, m := range matches {
+		if m.Round == 1 {
+			if len(m.Slots) != 4 || m.Status != domain.MatchReady || m.AdvanceCount != 2 {
+				t.Errorf("heat %d: expected 4 seated participants, ready, 2 advancing", m.Position)
+			}
+			final := byID[*m.NextMatchID]
+			if final.Round != 2 || len(final.Slots) != 4 || final.AdvanceCount != 0 {
+				t.Errorf("heat %d should feed a 4 slot final", m.Position)
+			}
+		}
+	}
+
+	// Seeds 1 and 2 start in different heats
+	first := matches[0]
+	for _, slot := range first.Slots {
+		if *slot.Seed == 2 {
+			t.Error("seeds 1 and 2 should not share a first round heat")
+		}
+	}
+
+	// Each heat fills its own block of final slots
+	if HeatSlot(matches[0], 4, 1) != 1 || HeatSlot(matches[1], 4, 2) != 4 {
+		t.Error("unexpected final slot for heat finishers")
+	}
+}
+
+func TestFreeForAll_HeatByes(t *testing.T) {
+	// 10 participants need 4 heats of 4; two heats only have the 2 who advance anyway
+	matches, err := FreeForAll(1, makeParticipants(10), 4, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	heats, byes := 0, 0
+	for _, m := range matches {
+		if m.Round != 1 {
+			continue
+		}
+		heats++
+		if m.Status == domain.MatchCompleted {
+			byes++
+			if m.WinnerID == nil || *m.Slots[0].Placement != 1 {
+				t.Errorf("heat %d: expected the better seed to be placed first", m.Position)
+			}
+		}
+	}
+	if heats != 4 || byes != 2 {
+		t.Errorf("expected 4 first round heats with 2 byes, got %d with %d", heats, byes)
+	}
+}
+
+func TestSpreadPosition_SeparatesTopSeeds(t *testing.T) {
+	// With 4 heats feeding pairs of heats, heats of seeds 1 and 2 must be in different halves
+	positions := make([]int, 4)
+	for i := range positions {
+		positions[i] = spreadPosition(i, 2, 2)
+	}
+	if positions[0]/2 == positions[1]/2 {
+		t.Errorf("seeds 1 and 2 share a branch: %v", positions)
+	}
+}
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// Sizing modes for fields that are not a power of two.
+const (
+	// SizingByes rounds the bracket up and gives the top seeds byes.
+	SizingByes = "byes"
+	// SizingPlayIn rounds the bracket down and has the lowest seeds play in.
+	SizingPlayIn = "play_in"
+)
+
+// SingleEliminationPlayIn generates a single elimination bracket sized down to the
+// largest power of two that fits the field instead of up with byes. The lowest seeds
+// play a play-in round (round 1) for the remaining spots and everyone else starts in
+// round 2. Each play-in pits the seed holding a spot against the seed that would have
+// been their first round opponent in a bracket with byes, and its winner takes that
+// spot, so the main bracket keeps the GenerateSeedPairings order.
+func SingleEliminationPlayIn(tournamentID uint64, participants []domain.Participant) ([]*domain.Match, error) {
+	if len(participants) < 2 {
+		return nil, fmt.Errorf("need at least 2 participants, got %d", len(participants))
+	}
+
+	mainSize := CalculatePlayInBracketSize(len(participants))
+	if mainSize == len(participants) {
+		// Nothing to play in for
+		return SingleElimination(tournamentID, participants)
+	}
+
+	// Sort participants by seed
+	sorted := make([]domain.Participant, len(participants))
+	copy(sorted, participants)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Seed < sorted[j].Seed
+	})
+
+	seedMap := make(map[int]*domain.Participant)
+	for i := range sorted {
+		seedMap[i+1] = &sorted[i]
+	}
+
+	// Seeds above the cut go straight into the main bracket
+	direct := 2*mainSize - len(participants)
+	directSeed := func(seed int) *domain.Participant {
+		if seed > direct {
+			return nil
+		}
+		return seedMap[seed]
+	}
+
+	var playIns, round2 []*domain.Match
+	for i, pair := range GenerateSeedPairings(mainSize) {
+		round2 = append(round2, createMatch(tournamentID, 2, i+1, directSeed(pair[0]), directSeed(pair[1])))
+
+		// A spot below the cut is filled by the winner of the play-in feeding it
+		for slot, seed := range pair {
+			if seed > direct {
+				opponent := 2*mainSize + 1 - seed
+				playIns = append(playIns, createMatch(tournamentID, 1, 2*i+slot+1, seedMap[seed], seedMap[opponent]))
+			}
+		}
+	}
+
+	matches := append(playIns, round2...)
+
+	// Later round placeholders
+	numMatches := mainSize / 2
+	for round := 3; round <= TotalRounds(mainSize)+1; round++ {
+		numMatches /= 2
+		for i := range numMatches {
+			matches = append(matches, &domain.Match{
+				TournamentID: tournamentID,
+				BracketType:  domain.BracketWinners,
+				Round:        round,
+				Position:     i + 1,
+				Status:       domain.MatchPending,
+			})
+		}
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestSingleEliminationPlayIn_FortyParticipants(t *testing.T) {
+	matches, err := SingleEliminationPlayIn(1, makeParticipants(40))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 39 {
+		t.Fatalf("expected 39 matches and no byes, got %d", len(matches))
+	}
+
+	byID := assignIDs(matches)
+	LinkMatches(matches)
+
+	playIns := 0
+	for _, m := range matches {
+		if m.Round == 1 {
+			playIns++
+			if *m.Seed1 < 25 || *m.Seed1+*m.Seed2 != 65 {
+				t.Errorf("play-in %d: unexpected pairing %d vs %d", m.Position, *m.Seed1, *m.Seed2)
+			}
+
+			// The winner takes the better seed's spot against the seed they would
+			// have met in round 2 of a bracket with byes
+			next := byID[*m.NextMatchID]
+			opponent := next.Seed1
+			if WinnerSlot(m, next) == 1 {
+				opponent = next.Seed2
+			}
+			if opponent == nil || *opponent+*m.Seed1 != 33 {
+				t.Errorf("play-in for seed %d feeds the wrong round 2 match", *m.Seed1)
+			}
+		}
+		if m.Status == domain.MatchCompleted {
+			t.Errorf("match %d should not be a bye", m.ID)
+		}
+	}
+	if playIns != 8 {
+		t.Errorf("expected 8 play-in matches, got %d", playIns)
+	}
+}
+
+func TestSingleEliminationPlayIn_PowerOfTwo(t *testing.T) {
+	matches, _ := SingleEliminationPlayIn(1, makeParticipants(8))
+	if len(matches) != 7 {
+		t.Fatalf("expected a plain 8 player bracket, got %d matches", len(matches))
+	}
+	for _, m := range matches {
+		if m.Round == 1 && m.Status != domain.MatchReady {
+			t.Errorf("round 1 match %d should be ready", m.Position)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package engine
+
+import "github.com/braccet/bracket/internal/domain"
+
+// Projection is who plays in a match if every match before it goes to the better seed.
+type Projection struct {
+	Participant1 *domain.Participant
+	Participant2 *domain.Participant
+}
+
+// ProjectSeeds works out who plays in each match if every match goes to the better
+// (lower) seed, so organizers can see the expected path of each seed through a bracket.
+// Participants already in a match and results already in are kept. A match is only
+// projected forward once both of its participants are known; byes must already be
+// settled. Heats are not projected.
+func ProjectSeeds(matches []*domain.Match) map[uint64]Projection {
+	byID := make(map[uint64]*domain.Match, len(matches))
+	projections := make(map[uint64]Projection, len(matches))
+	for _, m := range matches {
+		if m.BracketType == domain.BracketHeat {
+			continue
+		}
+		byID[m.ID] = m
+		projections[m.ID] = Projection{
+			Participant1: slotParticipant(m.Participant1ID, m.Participant1Name, m.Seed1),
+			Participant2: slotParticipant(m.Participant2ID, m.Participant2Name, m.Seed2),
+		}
+	}
+
+	place := func(target *domain.Match, slot int, p *domain.Participant) bool {
+		projection := projections[target.ID]
+		current := &projection.Participant1
+		if slot == 2 {
+			current = &projection.Participant2
+		}
+		if *current != nil {
+			return false
+		}
+		*current = p
+		projections[target.ID] = projection
+		return true
+	}
+
+	// Each pass moves projected winners and losers one match further along
+	for changed := true; changed; {
+		changed = false
+		for _, m := range matches {
+			if _, ok := byID[m.ID]; !ok {
+				continue
+			}
+			winner, loser := projectedResult(m, projections[m.ID])
+			if winner == nil {
+				continue
+			}
+			if m.NextMatchID != nil {
+				if next, ok := byID[*m.NextMatchID]; ok && place(next, WinnerSlot(m, next), winner) {
+					changed = true
+				}
+			}
+			if m.LoserMatchID != nil && loser != nil {
+				if target, ok := byID[*m.LoserMatchID]; ok && place(target, LoserSlot(m, target), loser) {
+					changed = true
+				}
+			}
+		}
+	}
+
+	return projections
+}
+
+// projectedResult returns the projected winner and loser of a match: the actual result
+// if it has been played, otherwise the better seed wins. Both are nil until both
+// participants are known.
+func projectedResult(m *domain.Match, projection Projection) (winner, loser *domain.Participant) {
+	p1, p2 := projection.Participant1, projection.Participant2
+	if m.Status == domain.MatchCompleted {
+		switch {
+		case m.WinnerID == nil:
+			return nil, nil
+		case p1 != nil && p1.ID == *m.WinnerID:
+			return p1, p2
+		case p2 != nil && p2.ID == *m.WinnerID:
+			return p2, p1
+		}
+		return nil, nil
+	}
+
+	if p1 == nil || p2 == nil {
+		return nil, nil
+	}
+	if p2.Seed != 0 && (p1.Seed == 0 || p2.Seed < p1.Seed) {
+		return p2, p1
+	}
+	return p1, p2
+}
+
+func slotParticipant(id *uint64, name *string, seed *int) *domain.Participant {
+	if id == nil {
+		return nil
+	}
+	p := &domain.Participant{ID: *id}
+	if name != nil {
+		p.Name = *name
+	}
+	if seed != nil {
+		p.Seed = *seed
+	}
+	return p
+}
@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestProjectSeeds_BetterSeedsAdvance(t *testing.T) {
+	matches, err := SingleElimination(1, makeParticipants(8))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assignIDs(matches)
+	LinkMatches(matches)
+
+	projections := ProjectSeeds(matches)
+
+	for _, m := range matches {
+		p := projections[m.ID]
+		if p.Participant1 == nil || p.Participant2 == nil {
+			t.Fatalf("match R%dM%d: expected both participants projected", m.Round, m.Position)
+		}
+		seeds := []int{p.Participant1.Seed, p.Participant2.Seed}
+		switch m.Round {
+		case 2:
+			if seeds[0]+seeds[1] != 5 {
+				t.Errorf("semifinal R2M%d: expected seeds summing to 5, got %v", m.Position, seeds)
+			}
+		case 3:
+			if seeds[0]+seeds[1] != 3 {
+				t.Errorf("final: expected seeds 1 and 2, got %v", seeds)
+			}
+		}
+	}
+}
+
+func TestProjectSeeds_KeepsResults(t *testing.T) {
+	matches, err := SingleElimination(1, makeParticipants(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assignIDs(matches)
+	LinkMatches(matches)
+
+	// Seed 4 upsets seed 1 in the first round
+	var upset *domain.Match
+	for _, m := range matches {
+		if m.Round == 1 && m.Seed1 != nil && *m.Seed1 == 1 {
+			upset = m
+		}
+	}
+	if upset == nil {
+		t.Fatal("expected a first round match for seed 1")
+	}
+	upset.Status = domain.MatchCompleted
+	upset.WinnerID = upset.Participant2ID
+
+	projections := ProjectSeeds(matches)
+
+	final := projections[*upset.NextMatchID]
+	for _, p := range []*domain.Participant{final.Participant1, final.Participant2} {
+		if p == nil {
+			t.Fatal("expected both finalists projected")
+		}
+		if p.Seed == 1 {
+			t.Error("seed 1 lost but was projected into the final")
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// RoundRobin generates a round robin where every participant plays every other
+// participant once. Pairings are produced with the circle method: the top seed stays
+// fixed while everyone else rotates one place per round. With an odd number of
+// participants a bye is added and whoever is paired with it sits the round out.
+// Round robin matches have no NextMatchID; results only feed the standings.
+func RoundRobin(tournamentID uint64, participants []domain.Participant) ([]*domain.Match, error) {
+	if len(participants) < 2 {
+		return nil, fmt.Errorf("need at least 2 participants, got %d", len(participants))
+	}
+
+	// Sort participants by seed
+	sorted := make([]domain.Participant, len(participants))
+	copy(sorted, participants)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Seed < sorted[j].Seed
+	})
+
+	// nil entries are byes
+	circle := make([]*domain.Participant, len(sorted))
+	for i := range sorted {
+		circle[i] = &sorted[i]
+	}
+	if len(circle)%2 == 1 {
+		circle = append(circle, nil)
+	}
+
+	n := len(circle)
+	var matches []*domain.Match
+
+	for round := 1; round < n; round++ {
+		position := 1
+		for i := range n / 2 {
+			p1, p2 := circle[i], circle[n-1-i]
+			if p1 == nil || p2 == nil {
+				continue
+			}
+			// Alternate sides for the fixed participant so they are not always slot 1
+			if i == 0 && round%2 == 0 {
+				p1, p2 = p2, p1
+			}
+
+			match := createMatch(tournamentID, round, position, p1, p2)
+			match.BracketType = domain.BracketRoundRobin
+			matches = append(matches, match)
+			position++
+		}
+
+		// Rotate everyone but the first participant one place clockwise
+		last := circle[n-1]
+		copy(circle[2:], circle[1:n-1])
+		circle[1] = last
+	}
+
+	return matches, nil
+}
+
+// RoundRobinRounds returns the number of rounds needed for a round robin.
+func RoundRobinRounds(participantCount int) int {
+	if participantCount < 2 {
+		return 0
+	}
+	if participantCount%2 == 1 {
+		return participantCount
+	}
+	return participantCount - 1
+}
+
+// IsRoundRobin reports whether the matches form a round robin, where nobody advances
+// and the champion is decided by the standings instead of a final.
+func IsRoundRobin(matches []*domain.Match) bool {
+	if len(matches) == 0 {
+		return false
+	}
+	for _, m := range matches {
+		if m.BracketType != domain.BracketRoundRobin {
+			return false
+		}
+	}
+	return true
+}
+
+// AllCompleted reports whether every match has been completed.
+func AllCompleted(matches []*domain.Match) bool {
+	for _, m := range matches {
+		if m.Status != domain.MatchCompleted {
+			return false
+		}
+	}
+	return true
+}
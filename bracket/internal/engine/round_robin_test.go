@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestRoundRobinRounds(t *testing.T) {
+	tests := []struct {
+		participants int
+		want         int
+	}{
+		{1, 0},
+		{2, 1},
+		{3, 3},
+		{4, 3},
+		{5, 5},
+		{8, 7},
+	}
+
+	for _, tt := range tests {
+		got := RoundRobinRounds(tt.participants)
+		if got != tt.want {
+			t.Errorf("RoundRobinRounds(%d) = %d, want %d", tt.participants, got, tt.want)
+		}
+	}
+}
+
+func TestRoundRobin_EveryPairPlaysOnce(t *testing.T) {
+	for _, n := range []int{2, 3, 4, 5, 6, 7, 8} {
+		matches, err := RoundRobin(1, makeParticipants(n))
+		if err != nil {
+			t.Fatalf("unexpected error for %d participants: %v", n, err)
+		}
+
+		if want := n * (n - 1) / 2; len(matches) != want {
+			t.Errorf("%d participants: expected %d matches, got %d", n, want, len(matches))
+		}
+
+		pairs := make(map[[2]uint64]int)
+		playedInRound := make(map[[2]uint64]int)
+		maxRound := 0
+		for _, m := range matches {
+			if m.BracketType != domain.BracketRoundRobin {
+				t.Errorf("expected round_robin bracket type, got %s", m.BracketType)
+			}
+			if m.Status != domain.MatchReady {
+				t.Errorf("expected ready status, got %s", m.Status)
+			}
+			if m.NextMatchID != nil {
+				t.Error("round robin matches should not have a next match")
+			}
+
+			a, b := *m.Participant1ID, *m.Participant2ID
+			if a > b {
+				a, b = b, a
+			}
+			pairs[[2]uint64{a, b}]++
+			playedInRound[[2]uint64{a, uint64(m.Round)}]++
+			playedInRound[[2]uint64{b, uint64(m.Round)}]++
+			if m.Round > maxRound {
+				maxRound = m.Round
+			}
+		}
+
+		for pair, count := range pairs {
+			if count != 1 {
+				t.Errorf("%d participants: %v played %d times", n, pair, count)
+			}
+		}
+		for key, count := range playedInRound {
+			if count != 1 {
+				t.Errorf("%d participants: participant %d plays %d times in round %d", n, key[0], count, key[1])
+			}
+		}
+		if maxRound != RoundRobinRounds(n) {
+			t.Errorf("%d participants: expected %d rounds, got %d", n, RoundRobinRounds(n), maxRound)
+		}
+	}
+}
+
+func TestRoundRobin_MinimumParticipants(t *testing.T) {
+	_, err := RoundRobin(1, makeParticipants(1))
+	if err == nil {
+		t.Error("expected error for 1 participant")
+	}
+}
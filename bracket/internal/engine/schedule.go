@@ -0,0 +1,181 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// DefaultMatchDuration is how long a match is expected to take when no duration is set.
+const DefaultMatchDuration = 30 * time.Minute
+
+// ScheduleOptions describes the time and setups available to play a tournament.
+type ScheduleOptions struct {
+	Start    time.Time                         // No match is scheduled before this
+	Duration func(*domain.Match) time.Duration // Expected length of a match
+	Setups   int                               // Matches that can be played at once; 0 for no limit
+	Rest     time.Duration                     // Least time between two of a participant's matches
+}
+
+// Schedule projects a start time for every pending or ready match. A match starts once
+// the matches feeding it are over, each of its known participants has rested since
+// their last match, and a setup is free; among the matches that could start, the
+// earliest goes first, then the earliest round. Completed matches end when they were
+// completed. Matches in progress occupy a setup until their expected end, or until
+// Start if they are running over. Completed and in-progress matches are not in the
+// returned schedule.
+func Schedule(matches []*domain.Match, opts ScheduleOptions) map[uint64]time.Time {
+	duration := opts.Duration
+	if duration == nil {
+		duration = func(*domain.Match) time.Duration { return DefaultMatchDuration }
+	}
+
+	byID := make(map[uint64]bool, len(matches))
+	for _, m := range matches {
+		byID[m.ID] = true
+	}
+	feeders := make(map[uint64][]uint64)
+	for _, m := range matches {
+		for _, target := range []*uint64{m.NextMatchID, m.LoserMatchID} {
+			if target != nil && byID[*target] {
+				feeders[*target] = append(feeders[*target], m.ID)
+			}
+		}
+	}
+
+	ends := make(map[uint64]time.Time, len(matches))
+	free := make(map[uint64]time.Time) // When each participant last finished a match
+	setups := make([]time.Time, opts.Setups)
+	for i := range setups {
+		setups[i] = opts.Start
+	}
+	finish := func(m *domain.Match, end time.Time) {
+		ends[m.ID] = end
+		for _, id := range matchParticipants(m) {
+			if end.After(free[id]) {
+				free[id] = end
+			}
+		}
+	}
+
+	var unscheduled []*domain.Match
+	for _, m := range matches {
+		switch m.Status {
+		case domain.MatchCompleted:
+			var end time.Time // Byes settled without a completion time held nobody up
+			if m.CompletedAt != nil {
+				end = *m.CompletedAt
+			}
+			finish(m, end)
+		case domain.MatchInProgress:
+			start := opts.Start
+			if m.ScheduledAt != nil {
+				start = *m.ScheduledAt
+			}
+			end := laterOf(start.Add(duration(m)), opts.Start)
+			if len(setups) > 0 {
+				i := earliestSetup(setups)
+				setups[i] = laterOf(setups[i], end)
+			}
+			finish(m, end)
+		default:
+			unscheduled = append(unscheduled, m)
+		}
+	}
+
+	schedule := make(map[uint64]time.Time, len(unscheduled))
+	for len(unscheduled) > 0 {
+		next := -1
+		var nextStart time.Time
+		for i, m := range unscheduled {
+			start, ok := earliestStart(m, feeders[m.ID], ends, free, opts)
+			if !ok {
+				continue
+			}
+			if next == -1 || start.Before(nextStart) || (start.Equal(nextStart) && playsFirst(m, unscheduled[next])) {
+				next, nextStart = i, start
+			}
+		}
+		if next == -1 {
+			// Only matches fed by unscheduled matches outside the list are left
+			break
+		}
+
+		m := unscheduled[next]
+		if len(setups) > 0 {
+			i := earliestSetup(setups)
+			nextStart = laterOf(nextStart, setups[i])
+			setups[i] = nextStart.Add(duration(m))
+		}
+		schedule[m.ID] = nextStart
+		finish(m, nextStart.Add(duration(m)))
+		unscheduled = append(unscheduled[:next], unscheduled[next+1:]...)
+	}
+
+	return schedule
+}
+
+// earliestStart returns when a match could start if a setup were free, and false if a
+// match feeding it has not been scheduled yet.
+func earliestStart(m *domain.Match, feeders []uint64, ends, free map[uint64]time.Time, opts ScheduleOptions) (time.Time, bool) {
+	start := opts.Start
+	for _, id := range feeders {
+		end, ok := ends[id]
+		if !ok {
+			return time.Time{}, false
+		}
+		start = laterOf(start, end.Add(opts.Rest))
+	}
+	for _, id := range matchParticipants(m) {
+		if end, ok := free[id]; ok {
+			start = laterOf(start, end.Add(opts.Rest))
+		}
+	}
+	return start, true
+}
+
+// playsFirst breaks ties between matches that could start at the same time: earlier
+// stages and rounds go first, then the order the matches were generated in.
+func playsFirst(a, b *domain.Match) bool {
+	if a.Stage != b.Stage {
+		return a.Stage < b.Stage
+	}
+	if a.Round != b.Round {
+		return a.Round < b.Round
+	}
+	return a.ID < b.ID
+}
+
+// matchParticipants returns the participants known to be playing in a match.
+func matchParticipants(m *domain.Match) []uint64 {
+	var ids []uint64
+	if m.Participant1ID != nil {
+		ids = append(ids, *m.Participant1ID)
+	}
+	if m.Participant2ID != nil {
+		ids = append(ids, *m.Participant2ID)
+	}
+	for _, slot := range m.Slots {
+		if slot.ParticipantID != nil {
+			ids = append(ids, *slot.ParticipantID)
+		}
+	}
+	return ids
+}
+
+func earliestSetup(setups []time.Time) int {
+	earliest := 0
+	for i, t := range setups {
+		if t.Before(setups[earliest]) {
+			earliest = i
+		}
+	}
+	return earliest
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
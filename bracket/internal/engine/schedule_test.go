@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+var scheduleStart = time.Date(2025, 6, 7, 10, 0, 0, 0, time.UTC)
+
+// scheduledBracket generates a linked single elimination bracket with IDs assigned.
+func scheduledBracket(t *testing.T, n int) map[uint64]*domain.Match {
+	t.Helper()
+	matches, err := SingleElimination(1, makeParticipants(n))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	byID := assignIDs(matches)
+	LinkMatches(matches)
+	return byID
+}
+
+func findRound(byID map[uint64]*domain.Match, round, position int) *domain.Match {
+	for _, m := range byID {
+		if m.Round == round && m.Position == position {
+			return m
+		}
+	}
+	return nil
+}
+
+func matchList(byID map[uint64]*domain.Match) []*domain.Match {
+	matches := make([]*domain.Match, 0, len(byID))
+	for id := uint64(1); id <= uint64(len(byID)); id++ {
+		matches = append(matches, byID[id])
+	}
+	return matches
+}
+
+func TestSchedule_SetupsAndDependencies(t *testing.T) {
+	byID := scheduledBracket(t, 8)
+
+	schedule := Schedule(matchList(byID), ScheduleOptions{
+		Start:  scheduleStart,
+		Setups: 2,
+		Rest:   10 * time.Minute,
+	})
+
+	tests := []struct {
+		round, position int
+		offset          time.Duration
+	}{
+		// Two setups: the quarterfinals are played two at a time
+		{1, 1, 0},
+		{1, 2, 0},
+		{1, 3, 30 * time.Minute},
+		{1, 4, 30 * time.Minute},
+		// Semifinal 1 waits for a setup, semifinal 2 for its players to rest
+		{2, 1, 60 * time.Minute},
+		{2, 2, 70 * time.Minute},
+		// The final waits for the later semifinal and the rest after it
+		{3, 1, 110 * time.Minute},
+	}
+	for _, tt := range tests {
+		m := findRound(byID, tt.round, tt.position)
+		got, ok := schedule[m.ID]
+		if !ok {
+			t.Errorf("round %d match %d: not scheduled", tt.round, tt.position)
+			continue
+		}
+		if want := scheduleStart.Add(tt.offset); !got.Equal(want) {
+			t.Errorf("round %d match %d: expected %v, got %v", tt.round, tt.position, want, got)
+		}
+	}
+}
+
+func TestSchedule_ResultsEarlyAndLate(t *testing.T) {
+	byID := scheduledBracket(t, 8)
+
+	// Quarterfinal 1 finished early; quarterfinal 2 started on time and is running over
+	early := scheduleStart.Add(10 * time.Minute)
+	qf1 := findRound(byID, 1, 1)
+	qf1.Status = domain.MatchCompleted
+	qf1.WinnerID = qf1.Participant1ID
+	qf1.CompletedAt = &early
+	qf2 := findRound(byID, 1, 2)
+	qf2.Status = domain.MatchInProgress
+	qf2.ScheduledAt = &scheduleStart
+
+	now := scheduleStart.Add(45 * time.Minute)
+	schedule := Schedule(matchList(byID), ScheduleOptions{Start: now, Rest: 10 * time.Minute})
+
+	if _, ok := schedule[qf1.ID]; ok {
+		t.Error("expected the completed match not to be rescheduled")
+	}
+	if _, ok := schedule[qf2.ID]; ok {
+		t.Error("expected the match in progress not to be rescheduled")
+	}
+	if got := schedule[findRound(byID, 1, 3).ID]; !got.Equal(now) {
+		t.Errorf("expected unplayed matches to start now (%v), got %v", now, got)
+	}
+	// The overrunning quarterfinal is expected to finish now, then its winner rests
+	if got, want := schedule[findRound(byID, 2, 1).ID], now.Add(10*time.Minute); !got.Equal(want) {
+		t.Errorf("expected semifinal 1 at %v, got %v", want, got)
+	}
+}
+
+func TestSchedule_RestBetweenRoundRobinMatches(t *testing.T) {
+	matches, err := RoundRobin(1, makeParticipants(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assignIDs(matches)
+
+	schedule := Schedule(matches, ScheduleOptions{
+		Start:    scheduleStart,
+		Duration: func(*domain.Match) time.Duration { return 20 * time.Minute },
+		Rest:     15 * time.Minute,
+	})
+
+	for _, m := range matches {
+		want := scheduleStart.Add(time.Duration(m.Round-1) * 35 * time.Minute)
+		if got := schedule[m.ID]; !got.Equal(want) {
+			t.Errorf("round %d match %d: expected %v, got %v", m.Round, m.Position, want, got)
+		}
+	}
+}
@@ -12,6 +12,19 @@ func CalculateBracketSize(participants int) int {
 	return size
 }
 
+// CalculatePlayInBracketSize returns the largest power of 2 <= participants: the size
+// of the main bracket when the extra participants play in rather than receive byes.
+func CalculatePlayInBracketSize(participants int) int {
+	if participants <= 0 {
+		return 0
+	}
+	size := 1
+	for size*2 <= participants {
+		size *= 2
+	}
+	return size
+}
+
 // GenerateSeedPairings returns seed pairings for a bracket.
 // Uses standard tournament seeding where top seeds meet in later rounds.
 // For an 8-player bracket: [[1,8], [4,5], [2,7], [3,6]]
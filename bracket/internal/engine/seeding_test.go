@@ -32,6 +32,30 @@ func TestCalculateBracketSize(t *testing.T) {
 	}
 }
 
+func TestCalculatePlayInBracketSize(t *testing.T) {
+	tests := []struct {
+		participants int
+		want         int
+	}{
+		{0, 0},
+		{1, 1},
+		{2, 2},
+		{3, 2},
+		{5, 4},
+		{8, 8},
+		{40, 32},
+		{63, 32},
+		{64, 64},
+	}
+
+	for _, tt := range tests {
+		got := CalculatePlayInBracketSize(tt.participants)
+		if got != tt.want {
+			t.Errorf("CalculatePlayInBracketSize(%d) = %d, want %d", tt.participants, got, tt.want)
+		}
+	}
+}
+
 func TestTotalRounds(t *testing.T) {
 	tests := []struct {
 		bracketSize int
@@ -78,6 +78,58 @@ func SingleElimination(tournamentID uint64, participants []domain.Participant) (
 	return matches, nil
 }
 
+// AddThirdPlaceMatch appends a third-place match, played between the semifinal losers
+// alongside the final, to a single elimination bracket. Brackets too small to have
+// semifinals are returned unchanged.
+func AddThirdPlaceMatch(tournamentID uint64, matches []*domain.Match) []*domain.Match {
+	finalRound := 0
+	for _, m := range matches {
+		finalRound = max(finalRound, m.Round)
+	}
+	if finalRound < 2 {
+		return matches
+	}
+
+	return append(matches, &domain.Match{
+		TournamentID: tournamentID,
+		BracketType:  domain.BracketThirdPlace,
+		Round:        finalRound,
+		Position:     1,
+		Status:       domain.MatchPending,
+	})
+}
+
+// AddConsolationBracket appends a consolation bracket, a single elimination bracket
+// for the losers of the first round so they still get games. Brackets with fewer than
+// four first round matches get none: their first round losers are already the
+// semifinal losers, who meet in the third-place match.
+func AddConsolationBracket(tournamentID uint64, matches []*domain.Match) []*domain.Match {
+	finalRound := 0
+	for _, m := range matches {
+		finalRound = max(finalRound, m.Round)
+	}
+
+	// One slot per first round match (or spot for one, in a play-in round)
+	size := 1 << max(finalRound-1, 0)
+	if size < 4 {
+		return matches
+	}
+
+	for round := 1; size > 1; round++ {
+		size /= 2
+		for i := range size {
+			matches = append(matches, &domain.Match{
+				TournamentID: tournamentID,
+				BracketType:  domain.BracketConsolation,
+				Round:        round,
+				Position:     i + 1,
+				Status:       domain.MatchPending,
+			})
+		}
+	}
+	return matches
+}
+
 // createMatch creates a round 1 match with the given participants.
 // p1 or p2 can be nil to indicate a bye.
 func createMatch(tournamentID uint64, round, position int, p1, p2 *domain.Participant) *domain.Match {
@@ -131,9 +183,44 @@ func processBye(match *domain.Match) {
 	match.Status = domain.MatchCompleted
 }
 
-// LinkMatches sets NextMatchID for all matches based on bracket structure.
+// LinkMatches sets NextMatchID for all matches based on bracket structure, and routes
+// the semifinal losers to the third-place match and the first round losers to the
+// consolation bracket if the bracket has them.
 // Must be called after matches have been saved and have IDs assigned.
 func LinkMatches(matches []*domain.Match) {
+	var main, consolation []*domain.Match
+	var thirdPlace *domain.Match
+	for _, m := range matches {
+		switch m.BracketType {
+		case domain.BracketThirdPlace:
+			thirdPlace = m
+		case domain.BracketConsolation:
+			consolation = append(consolation, m)
+		default:
+			main = append(main, m)
+		}
+	}
+
+	byRound, maxRound := linkRounds(main)
+
+	if thirdPlace != nil {
+		for _, semifinal := range byRound[maxRound-1] {
+			semifinal.LoserMatchID = matchIDPtr(thirdPlace)
+		}
+	}
+
+	if len(consolation) > 0 {
+		consolationRounds, _ := linkRounds(consolation)
+		for _, match := range byRound[1] {
+			match.LoserMatchID = matchIDPtr(consolationRounds[1][(match.Position-1)/2])
+		}
+	}
+}
+
+// linkRounds links each match of a single elimination bracket to its match in the
+// next round. Returns the matches grouped by round (sorted by position) and the
+// number of rounds.
+func linkRounds(matches []*domain.Match) (map[int][]*domain.Match, int) {
 	// Group matches by round
 	byRound := make(map[int][]*domain.Match)
 	for _, m := range matches {
@@ -160,14 +247,27 @@ func LinkMatches(matches []*domain.Match) {
 		currentRound := byRound[round]
 		nextRound := byRound[round+1]
 
-		for i, match := range currentRound {
-			nextMatchIdx := i / 2
+		for _, match := range currentRound {
+			// Positions rather than indexes, as a play-in round only has some of its matches
+			nextMatchIdx := (match.Position - 1) / 2
 			if nextMatchIdx < len(nextRound) {
 				nextMatchID := nextRound[nextMatchIdx].ID
 				match.NextMatchID = &nextMatchID
 			}
 		}
 	}
+
+	return byRound, maxRound
+}
+
+// ThirdPlaceMatch returns the bracket's third-place match, or nil if it has none.
+func ThirdPlaceMatch(matches []*domain.Match) *domain.Match {
+	for _, m := range matches {
+		if m.BracketType == domain.BracketThirdPlace {
+			return m
+		}
+	}
+	return nil
 }
 
 // GetBracketState builds a BracketState summary from a list of matches.
@@ -179,9 +279,20 @@ func GetBracketState(tournamentID uint64, matches []*domain.Match) *BracketState
 	state := &BracketState{
 		TournamentID: tournamentID,
 		Format:       FormatSingleElim,
+		Stage:        CurrentStage(matches),
 		Matches:      matches,
 	}
 
+	// Format, rounds and completion describe the stage being played
+	matches = StageMatches(matches, state.Stage)
+
+	for _, m := range matches {
+		if m.BracketType == domain.BracketLosers || m.BracketType == domain.BracketGrandFinal {
+			state.Format = FormatDoubleElim
+			break
+		}
+	}
+
 	// Find total rounds and current round
 	for _, m := range matches {
 		if m.Round > state.TotalRounds {
@@ -197,16 +308,51 @@ func GetBracketState(tournamentID uint64, matches []*domain.Match) *BracketState
 		}
 	}
 
-	// Check if complete (final match has winner)
+	// Check if complete (deciding match has winner)
+	if championID := Champion(matches); championID != nil {
+		state.ChampionID = championID
+		state.IsComplete = SideMatchesDecided(matches)
+	}
+
+	return state
+}
+
+// SideMatchesDecided reports whether the bracket's third-place match and consolation
+// bracket, if any, have been played. The champion is known as soon as the final is
+// played, but the bracket is not finished until these are too.
+func SideMatchesDecided(matches []*domain.Match) bool {
 	for _, m := range matches {
-		if m.Round == state.TotalRounds && m.WinnerID != nil {
-			state.IsComplete = true
-			state.ChampionID = m.WinnerID
-			break
+		side := m.BracketType == domain.BracketThirdPlace || m.BracketType == domain.BracketConsolation
+		if side && m.Status != domain.MatchCompleted {
+			return false
 		}
 	}
+	return true
+}
 
-	return state
+// Champion returns the winner of the bracket's deciding match, or nil if it has not been played.
+// The deciding match is the last grand final match when the bracket has a grand final,
+// otherwise the final round of the winners bracket (never the third-place match) or the
+// final heat, whose winner is its first place finisher.
+func Champion(matches []*domain.Match) *uint64 {
+	var final *domain.Match
+	for _, m := range matches {
+		if m.BracketType == domain.BracketGrandFinal && (final == nil || m.Round > final.Round) {
+			final = m
+		}
+	}
+	if final == nil {
+		for _, m := range matches {
+			main := m.BracketType == domain.BracketWinners || m.BracketType == domain.BracketHeat
+			if main && (final == nil || m.Round > final.Round) {
+				final = m
+			}
+		}
+	}
+	if final == nil {
+		return nil
+	}
+	return final.WinnerID
 }
 
 // Format represents the bracket format.
@@ -215,12 +361,16 @@ type Format string
 const (
 	FormatSingleElim Format = "single_elimination"
 	FormatDoubleElim Format = "double_elimination"
+	FormatRoundRobin Format = "round_robin"
+	FormatSwiss      Format = "swiss"
+	FormatFreeForAll Format = "free_for_all"
 )
 
 // BracketState represents the current state of a tournament bracket.
 type BracketState struct {
 	TournamentID uint64
 	Format       Format
+	Stage        int
 	TotalRounds  int
 	CurrentRound int
 	Matches      []*domain.Match
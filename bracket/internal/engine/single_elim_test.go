@@ -290,3 +290,80 @@ func TestGetBracketState(t *testing.T) {
 		t.Errorf("expected 7 matches, got %d", len(state.Matches))
 	}
 }
+
+func TestSingleElimination_ThirdPlaceMatch(t *testing.T) {
+	matches, err := SingleElimination(1, makeParticipants(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	matches = AddThirdPlaceMatch(1, matches)
+	if len(matches) != 4 {
+		t.Fatalf("expected 4 matches, got %d", len(matches))
+	}
+	assignIDs(matches)
+	LinkMatches(matches)
+
+	thirdPlace := ThirdPlaceMatch(matches)
+	if thirdPlace == nil || thirdPlace.Round != 2 || thirdPlace.NextMatchID != nil {
+		t.Fatal("expected an unlinked third-place match in the final round")
+	}
+
+	var final *domain.Match
+	for _, m := range matches {
+		if m.BracketType == domain.BracketWinners && m.Round == 2 {
+			final = m
+		}
+	}
+	for _, m := range matches {
+		if m.Round != 1 {
+			continue
+		}
+		if m.NextMatchID == nil || *m.NextMatchID != final.ID {
+			t.Errorf("semifinal %d should feed the final", m.Position)
+		}
+		if m.LoserMatchID == nil || *m.LoserMatchID != thirdPlace.ID {
+			t.Errorf("semifinal %d should send its loser to the third-place match", m.Position)
+		}
+	}
+}
+
+func TestAddThirdPlaceMatch_NoSemifinals(t *testing.T) {
+	matches, _ := SingleElimination(1, makeParticipants(2))
+	if got := AddThirdPlaceMatch(1, matches); len(got) != 1 {
+		t.Errorf("expected no third-place match without semifinals, got %d matches", len(got))
+	}
+}
+
+func TestSingleElimination_ConsolationBracket(t *testing.T) {
+	matches, _ := SingleElimination(1, makeParticipants(8))
+	matches = AddConsolationBracket(1, matches)
+	if len(matches) != 10 {
+		t.Fatalf("expected 7 main and 3 consolation matches, got %d", len(matches))
+	}
+	byID := assignIDs(matches)
+	LinkMatches(matches)
+
+	for _, m := range matches {
+		switch {
+		case m.BracketType == domain.BracketWinners && m.Round == 1:
+			target := byID[*m.LoserMatchID]
+			if target.BracketType != domain.BracketConsolation || target.Round != 1 || target.Position != (m.Position+1)/2 {
+				t.Errorf("round 1 match %d should send its loser to consolation match %d", m.Position, (m.Position+1)/2)
+			}
+		case m.BracketType == domain.BracketWinners && m.LoserMatchID != nil:
+			t.Errorf("only round 1 losers should drop into the consolation bracket")
+		case m.BracketType == domain.BracketConsolation && m.Round == 1:
+			next := byID[*m.NextMatchID]
+			if next.BracketType != domain.BracketConsolation || next.Round != 2 {
+				t.Errorf("consolation match %d should feed the consolation final", m.Position)
+			}
+		}
+	}
+}
+
+func TestAddConsolationBracket_TooSmall(t *testing.T) {
+	matches, _ := SingleElimination(1, makeParticipants(4))
+	if got := AddConsolationBracket(1, matches); len(got) != 3 {
+		t.Errorf("expected no consolation bracket for 4 participants, got %d matches", len(got))
+	}
+}
@@ -0,0 +1,121 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// AssignPools splits participants into poolCount pools, seed-balanced with a snake
+// draft: seeds 1..N go to pools 1..N, the next N seeds go back from pool N to pool 1,
+// and so on. Every pool gets one participant from each tier of seeds and pool sizes
+// differ by at most one.
+func AssignPools(participants []domain.Participant, poolCount int) [][]domain.Participant {
+	if poolCount < 1 {
+		poolCount = 1
+	}
+
+	sorted := make([]domain.Participant, len(participants))
+	copy(sorted, participants)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Seed < sorted[j].Seed
+	})
+
+	pools := make([][]domain.Participant, poolCount)
+	for i, p := range sorted {
+		tier, idx := i/poolCount, i%poolCount
+		if tier%2 == 1 {
+			idx = poolCount - 1 - idx
+		}
+		pools[idx] = append(pools[idx], p)
+	}
+	return pools
+}
+
+// CurrentStage returns the latest stage that has matches, or 0 if there are none.
+func CurrentStage(matches []*domain.Match) int {
+	stage := 0
+	for _, m := range matches {
+		if m.Stage > stage {
+			stage = m.Stage
+		}
+	}
+	return stage
+}
+
+// StageMatches returns the matches that belong to a stage.
+func StageMatches(matches []*domain.Match, stage int) []*domain.Match {
+	var result []*domain.Match
+	for _, m := range matches {
+		if m.Stage == stage {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// IsPoolStage reports whether the matches are split into pools. Pool stages only send
+// qualifiers on to the next stage; they never decide the champion.
+func IsPoolStage(matches []*domain.Match) bool {
+	for _, m := range matches {
+		if m.Pool > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Qualifiers picks the top perPool finishers of each pool and seeds them for the next
+// stage. Seeding goes by finishing place first (every pool winner is seeded above
+// every runner-up), then by record within a place: score, the tiebreakers in order,
+// and finally the seed they entered the pool stage with, as in Standings. Head to head
+// never separates qualifiers from different pools. pools must be ranked standings.
+func Qualifiers(pools [][]Standing, perPool int, tiebreakers []Tiebreaker) []domain.Participant {
+	keys := append([]Tiebreaker{tiebreakerWins}, tiebreakers...)
+	var tiers [][]*Standing
+	for place := 0; place < perPool; place++ {
+		var tier []*Standing
+		for _, pool := range pools {
+			if place < len(pool) {
+				tier = append(tier, &pool[place])
+			}
+		}
+		tiers = append(tiers, rankGroup(tier, keys, nil))
+	}
+
+	var qualifiers []domain.Participant
+	for _, tier := range tiers {
+		for _, s := range tier {
+			qualifiers = append(qualifiers, domain.Participant{
+				ID:   s.ParticipantID,
+				Name: s.Name,
+				Seed: len(qualifiers) + 1,
+			})
+		}
+	}
+	return qualifiers
+}
+
+// PoolStandings ranks each pool of a stage separately. Matches outside pools are ranked
+// as a single group. The result is ordered by pool number.
+func PoolStandings(matches []*domain.Match, tiebreakers []Tiebreaker) [][]Standing {
+	byPool := make(map[int][]*domain.Match)
+	var pools []int
+	for _, m := range matches {
+		if _, ok := byPool[m.Pool]; !ok {
+			pools = append(pools, m.Pool)
+		}
+		byPool[m.Pool] = append(byPool[m.Pool], m)
+	}
+	sort.Ints(pools)
+
+	result := make([][]Standing, len(pools))
+	for i, pool := range pools {
+		standings := Standings(byPool[pool], tiebreakers)
+		for j := range standings {
+			standings[j].Pool = pool
+		}
+		result[i] = standings
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestAssignPools_SnakeDraft(t *testing.T) {
+	pools := AssignPools(makeParticipants(10), 3)
+
+	want := [][]int{{1, 6, 7}, {2, 5, 8}, {3, 4, 9, 10}}
+	if len(pools) != len(want) {
+		t.Fatalf("expected %d pools, got %d", len(want), len(pools))
+	}
+	for i, pool := range pools {
+		if len(pool) != len(want[i]) {
+			t.Fatalf("pool %d: expected %d participants, got %d", i+1, len(want[i]), len(pool))
+		}
+		for j, p := range pool {
+			if p.Seed != want[i][j] {
+				t.Errorf("pool %d: expected seed %d at position %d, got %d", i+1, want[i][j], j, p.Seed)
+			}
+		}
+	}
+}
+
+func TestQualifiers_SeededByPlaceThenRecord(t *testing.T) {
+	pools := [][]Standing{
+		{
+			{Rank: 1, ParticipantID: 10, Seed: 1, Wins: 2},
+			{Rank: 2, ParticipantID: 11, Seed: 4, Wins: 1},
+			{Rank: 3, ParticipantID: 12, Seed: 5, Wins: 0},
+		},
+		{
+			{Rank: 1, ParticipantID: 20, Seed: 2, Wins: 2, SetsWon: 4},
+			{Rank: 2, ParticipantID: 21, Seed: 3, Wins: 1, SetsWon: 2, SetsLost: 3},
+			{Rank: 3, ParticipantID: 22, Seed: 6, Wins: 0},
+		},
+	}
+
+	qualifiers := Qualifiers(pools, 2, DefaultTiebreakers)
+
+	// Equal records within each place are separated by set differential
+	want := []uint64{20, 10, 11, 21}
+	if len(qualifiers) != len(want) {
+		t.Fatalf("expected %d qualifiers, got %d", len(want), len(qualifiers))
+	}
+	for i, q := range qualifiers {
+		if q.ID != want[i] || q.Seed != i+1 {
+			t.Errorf("seed %d: expected participant %d, got %d (seed %d)", i+1, want[i], q.ID, q.Seed)
+		}
+	}
+}
+
+func TestQualifiers_ScoreAndConfiguredTiebreakers(t *testing.T) {
+	pools := [][]Standing{
+		{
+			{Rank: 1, ParticipantID: 10, Seed: 1, Wins: 2},
+			{Rank: 2, ParticipantID: 11, Seed: 4, Wins: 1, SetsWon: 5, SetsLost: 4},
+		},
+		{
+			{Rank: 1, ParticipantID: 20, Seed: 2, Wins: 1, Draws: 3},
+			{Rank: 2, ParticipantID: 21, Seed: 3, Wins: 1, SetsWon: 3},
+		},
+	}
+
+	// Three draws outscore one more win; sets won decides over set differential
+	qualifiers := Qualifiers(pools, 2, []Tiebreaker{TiebreakerSetsWon})
+
+	want := []uint64{20, 10, 11, 21}
+	if len(qualifiers) != len(want) {
+		t.Fatalf("expected %d qualifiers, got %d", len(want), len(qualifiers))
+	}
+	for i, q := range qualifiers {
+		if q.ID != want[i] {
+			t.Errorf("seed %d: expected participant %d, got %d", i+1, want[i], q.ID)
+		}
+	}
+}
+
+func TestStageMatches(t *testing.T) {
+	matches := []*domain.Match{{Stage: 1, Pool: 1}, {Stage: 1, Pool: 2}, {Stage: 2}}
+
+	if CurrentStage(matches) != 2 {
+		t.Errorf("expected current stage 2, got %d", CurrentStage(matches))
+	}
+	if !IsPoolStage(StageMatches(matches, 1)) {
+		t.Error("expected stage 1 to be a pool stage")
+	}
+	if IsPoolStage(StageMatches(matches, 2)) {
+		t.Error("expected stage 2 not to be a pool stage")
+	}
+}
@@ -0,0 +1,258 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// Tiebreaker is a rule used to order participants with the same number of wins.
+// A draw counts as half a win throughout.
+type Tiebreaker string
+
+const (
+	// TiebreakerHeadToHead ranks by wins in matches played between the tied participants.
+	TiebreakerHeadToHead Tiebreaker = "head_to_head"
+	// TiebreakerSetDifferential ranks by sets won minus sets lost.
+	TiebreakerSetDifferential Tiebreaker = "set_differential"
+	// TiebreakerSetsWon ranks by total sets won.
+	TiebreakerSetsWon Tiebreaker = "sets_won"
+	// TiebreakerBuchholz ranks by the sum of the wins of every opponent faced.
+	TiebreakerBuchholz Tiebreaker = "buchholz"
+	// TiebreakerOpponentWinPercentage ranks by the average match win percentage of
+	// every opponent faced, each floored at one third.
+	TiebreakerOpponentWinPercentage Tiebreaker = "opponent_win_percentage"
+
+	// tiebreakerWins is the primary ranking key, applied before any configured tiebreaker.
+	tiebreakerWins Tiebreaker = "wins"
+)
+
+// DefaultTiebreakers is used when a round robin does not configure its own order.
+var DefaultTiebreakers = []Tiebreaker{TiebreakerHeadToHead, TiebreakerSetDifferential}
+
+// DefaultSwissTiebreakers is used when a Swiss event does not configure its own order.
+// Head to head is rarely decisive in Swiss, where most tied players never met.
+var DefaultSwissTiebreakers = []Tiebreaker{TiebreakerBuchholz, TiebreakerOpponentWinPercentage}
+
+// minOpponentWinPercentage keeps opponents with very poor records from dragging the
+// opponent win percentage down too far (the usual Swiss convention).
+const minOpponentWinPercentage = 1.0 / 3
+
+// ValidTiebreaker reports whether t is a known tiebreaker.
+func ValidTiebreaker(t Tiebreaker) bool {
+	switch t {
+	case TiebreakerHeadToHead, TiebreakerSetDifferential, TiebreakerSetsWon,
+		TiebreakerBuchholz, TiebreakerOpponentWinPercentage:
+		return true
+	}
+	return false
+}
+
+// Standing is one participant's row in the standings table.
+type Standing struct {
+	Rank          int // Within the pool, if the matches are split into pools
+	Pool          int
+	ParticipantID uint64
+	Name          string
+	Seed          int
+	Played        int // Matches played against an opponent (byes excluded)
+	Wins          int // Includes byes
+	Draws         int
+	Losses        int
+	Byes          int
+	SetsWon       int
+	SetsLost      int
+
+	Buchholz              float64
+	OpponentWinPercentage float64
+}
+
+// Score returns wins plus half a win for each draw.
+func (s Standing) Score() float64 {
+	return float64(s.Wins) + float64(s.Draws)/2
+}
+
+// SetDifferential returns sets won minus sets lost.
+func (s Standing) SetDifferential() int {
+	return s.SetsWon - s.SetsLost
+}
+
+// matchWinPercentage returns the share of matches won against an opponent (byes excluded).
+func (s Standing) matchWinPercentage() float64 {
+	if s.Played == 0 {
+		return 0
+	}
+	return (s.Score() - float64(s.Byes)) / float64(s.Played)
+}
+
+// Standings computes the standings table for a set of round robin or Swiss matches.
+// Participants are ranked by wins, then by each tiebreaker in order. Tiebreakers are
+// applied within each group that is still tied, so head-to-head only counts matches
+// between the participants it is separating. Any tie left after all tiebreakers is
+// broken by seed. Match sets must be loaded for set based tiebreakers to apply.
+// A completed match with a single participant is a bye and counts as a win. A drawn
+// match counts as half a win for each participant.
+func Standings(matches []*domain.Match, tiebreakers []Tiebreaker) []Standing {
+	rows := make(map[uint64]*Standing)
+	addRow := func(id *uint64, name *string, seed *int) {
+		if id == nil || rows[*id] != nil {
+			return
+		}
+		row := &Standing{ParticipantID: *id}
+		if name != nil {
+			row.Name = *name
+		}
+		if seed != nil {
+			row.Seed = *seed
+		}
+		rows[*id] = row
+	}
+
+	opponents := make(map[uint64][]uint64)
+	for _, m := range matches {
+		addRow(m.Participant1ID, m.Participant1Name, m.Seed1)
+		addRow(m.Participant2ID, m.Participant2Name, m.Seed2)
+
+		if m.Status != domain.MatchCompleted || (m.WinnerID == nil && !m.Draw) {
+			continue
+		}
+		if m.Participant1ID == nil || m.Participant2ID == nil {
+			row := rows[*m.WinnerID]
+			row.Wins++
+			row.Byes++
+			continue
+		}
+
+		p1, p2 := rows[*m.Participant1ID], rows[*m.Participant2ID]
+		opponents[p1.ParticipantID] = append(opponents[p1.ParticipantID], p2.ParticipantID)
+		opponents[p2.ParticipantID] = append(opponents[p2.ParticipantID], p1.ParticipantID)
+		p1.Played++
+		p2.Played++
+		switch {
+		case m.Draw:
+			p1.Draws++
+			p2.Draws++
+		case *m.WinnerID == p1.ParticipantID:
+			p1.Wins++
+			p2.Losses++
+		default:
+			p2.Wins++
+			p1.Losses++
+		}
+
+		for _, set := range m.Sets {
+			if set.Participant1Score > set.Participant2Score {
+				p1.SetsWon++
+				p2.SetsLost++
+			} else if set.Participant2Score > set.Participant1Score {
+				p2.SetsWon++
+				p1.SetsLost++
+			}
+		}
+	}
+
+	// Opponent strength, from the final records
+	for id, row := range rows {
+		for _, opponentID := range opponents[id] {
+			opponent := rows[opponentID]
+			row.Buchholz += opponent.Score()
+			row.OpponentWinPercentage += max(opponent.matchWinPercentage(), minOpponentWinPercentage)
+		}
+		if n := len(opponents[id]); n > 0 {
+			row.OpponentWinPercentage /= float64(n)
+		}
+	}
+
+	group := make([]*Standing, 0, len(rows))
+	for _, row := range rows {
+		group = append(group, row)
+	}
+
+	keys := append([]Tiebreaker{tiebreakerWins}, tiebreakers...)
+	ranked := rankGroup(group, keys, matches)
+
+	standings := make([]Standing, len(ranked))
+	for i, row := range ranked {
+		row.Rank = i + 1
+		standings[i] = *row
+	}
+	return standings
+}
+
+// rankGroup orders a group of tied participants by the first key, then recursively
+// breaks any remaining ties with the following keys.
+func rankGroup(group []*Standing, keys []Tiebreaker, matches []*domain.Match) []*Standing {
+	if len(group) <= 1 || len(keys) == 0 {
+		sort.Slice(group, func(i, j int) bool {
+			if group[i].Seed != group[j].Seed {
+				return group[i].Seed < group[j].Seed
+			}
+			return group[i].ParticipantID < group[j].ParticipantID
+		})
+		return group
+	}
+
+	score := tiebreakerScores(keys[0], group, matches)
+	sort.SliceStable(group, func(i, j int) bool {
+		return score[group[i].ParticipantID] > score[group[j].ParticipantID]
+	})
+
+	ranked := make([]*Standing, 0, len(group))
+	for start := 0; start < len(group); {
+		end := start + 1
+		for end < len(group) && score[group[end].ParticipantID] == score[group[start].ParticipantID] {
+			end++
+		}
+		ranked = append(ranked, rankGroup(group[start:end], keys[1:], matches)...)
+		start = end
+	}
+	return ranked
+}
+
+// tiebreakerScores returns each group member's value for a tiebreaker (higher is better).
+func tiebreakerScores(key Tiebreaker, group []*Standing, matches []*domain.Match) map[uint64]float64 {
+	score := make(map[uint64]float64, len(group))
+	switch key {
+	case tiebreakerWins:
+		for _, row := range group {
+			score[row.ParticipantID] = row.Score()
+		}
+	case TiebreakerSetDifferential:
+		for _, row := range group {
+			score[row.ParticipantID] = float64(row.SetDifferential())
+		}
+	case TiebreakerSetsWon:
+		for _, row := range group {
+			score[row.ParticipantID] = float64(row.SetsWon)
+		}
+	case TiebreakerBuchholz:
+		for _, row := range group {
+			score[row.ParticipantID] = row.Buchholz
+		}
+	case TiebreakerOpponentWinPercentage:
+		for _, row := range group {
+			score[row.ParticipantID] = row.OpponentWinPercentage
+		}
+	case TiebreakerHeadToHead:
+		inGroup := make(map[uint64]bool, len(group))
+		for _, row := range group {
+			inGroup[row.ParticipantID] = true
+		}
+		for _, m := range matches {
+			if m.Status != domain.MatchCompleted || m.Participant1ID == nil || m.Participant2ID == nil {
+				continue
+			}
+			if !inGroup[*m.Participant1ID] || !inGroup[*m.Participant2ID] {
+				continue
+			}
+			switch {
+			case m.Draw:
+				score[*m.Participant1ID] += 0.5
+				score[*m.Participant2ID] += 0.5
+			case m.WinnerID != nil:
+				score[*m.WinnerID]++
+			}
+		}
+	}
+	return score
+}
@@ -0,0 +1,195 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// playedMatch builds a completed round robin match with the given sets.
+func playedMatch(p1, p2 uint64, sets ...[2]int) *domain.Match {
+	m := &domain.Match{
+		BracketType:    domain.BracketRoundRobin,
+		Participant1ID: &p1,
+		Participant2ID: &p2,
+		Status:         domain.MatchCompleted,
+	}
+	var p1Sets, p2Sets int
+	for i, s := range sets {
+		m.Sets = append(m.Sets, domain.Set{SetNumber: i + 1, Participant1Score: s[0], Participant2Score: s[1]})
+		if s[0] > s[1] {
+			p1Sets++
+		} else {
+			p2Sets++
+		}
+	}
+	if p1Sets > p2Sets {
+		m.WinnerID = &p1
+	} else {
+		m.WinnerID = &p2
+	}
+	return m
+}
+
+func rankOrder(standings []Standing) []uint64 {
+	order := make([]uint64, len(standings))
+	for i, s := range standings {
+		order[i] = s.ParticipantID
+	}
+	return order
+}
+
+func equalOrder(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestStandings_WinsAndSets(t *testing.T) {
+	matches := []*domain.Match{
+		playedMatch(1, 2, [2]int{2, 0}, [2]int{2, 1}),
+		playedMatch(1, 3, [2]int{0, 2}, [2]int{2, 0}, [2]int{0, 2}),
+		playedMatch(2, 3, [2]int{2, 0}, [2]int{2, 0}),
+	}
+
+	standings := Standings(matches, DefaultTiebreakers)
+	if len(standings) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(standings))
+	}
+
+	for _, s := range standings {
+		if s.Played != 2 || s.Wins != 1 || s.Losses != 1 {
+			t.Errorf("participant %d: expected 1-1 in 2 played, got %d-%d in %d", s.ParticipantID, s.Wins, s.Losses, s.Played)
+		}
+	}
+
+	// Three-way tie on wins and head to head; set differential: 1 = +1, 2 = +0, 3 = -1
+	if got := rankOrder(standings); !equalOrder(got, []uint64{1, 2, 3}) {
+		t.Errorf("expected order [1 2 3], got %v", got)
+	}
+	if standings[0].SetsWon != 3 || standings[0].SetsLost != 2 {
+		t.Errorf("expected participant 1 to have 3-2 sets, got %d-%d", standings[0].SetsWon, standings[0].SetsLost)
+	}
+}
+
+func TestStandings_TiebreakerOrder(t *testing.T) {
+	// 1 and 2 both finish 2-1. 2 beat 1 head to head, but 1 has the better set differential.
+	matches := []*domain.Match{
+		playedMatch(1, 2, [2]int{0, 2}, [2]int{1, 2}),
+		playedMatch(1, 3, [2]int{2, 0}, [2]int{2, 0}),
+		playedMatch(1, 4, [2]int{2, 0}, [2]int{2, 0}),
+		playedMatch(2, 3, [2]int{2, 1}, [2]int{1, 2}, [2]int{2, 1}),
+		playedMatch(2, 4, [2]int{0, 2}, [2]int{0, 2}),
+		playedMatch(3, 4, [2]int{2, 0}, [2]int{2, 0}),
+	}
+
+	tests := []struct {
+		name        string
+		tiebreakers []Tiebreaker
+		want        []uint64
+	}{
+		{"head to head first", []Tiebreaker{TiebreakerHeadToHead, TiebreakerSetDifferential}, []uint64{2, 1}},
+		{"set differential first", []Tiebreaker{TiebreakerSetDifferential, TiebreakerHeadToHead}, []uint64{1, 2}},
+		{"no tiebreakers falls back to seed", nil, []uint64{1, 2}},
+	}
+
+	for _, tt := range tests {
+		standings := Standings(matches, tt.tiebreakers)
+		if got := rankOrder(standings)[:2]; !equalOrder(got, tt.want) {
+			t.Errorf("%s: expected top two %v, got %v", tt.name, tt.want, got)
+		}
+		for i, s := range standings {
+			if s.Rank != i+1 {
+				t.Errorf("%s: expected rank %d at index %d, got %d", tt.name, i+1, i, s.Rank)
+			}
+		}
+	}
+}
+
+func TestStandings_IgnoresUnplayedMatches(t *testing.T) {
+	p1, p2 := uint64(1), uint64(2)
+	matches := []*domain.Match{
+		{BracketType: domain.BracketRoundRobin, Participant1ID: &p1, Participant2ID: &p2, Status: domain.MatchReady},
+	}
+
+	standings := Standings(matches, DefaultTiebreakers)
+	if len(standings) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(standings))
+	}
+	for _, s := range standings {
+		if s.Played != 0 {
+			t.Errorf("participant %d: expected 0 played, got %d", s.ParticipantID, s.Played)
+		}
+	}
+}
+
+func TestStandings_SwissTiebreakers(t *testing.T) {
+	// 1 and 2 both finish 1-1. 1 lost to 3 (who went 2-0), 2 lost to 4 (who went 1-1).
+	matches := []*domain.Match{
+		playedMatch(3, 1, [2]int{2, 0}),
+		playedMatch(2, 4, [2]int{0, 2}),
+		playedMatch(1, 5, [2]int{2, 0}),
+		playedMatch(2, 6, [2]int{2, 0}),
+		playedMatch(3, 4, [2]int{2, 0}),
+	}
+
+	standings := Standings(matches, DefaultSwissTiebreakers)
+	byID := make(map[uint64]Standing)
+	for _, s := range standings {
+		byID[s.ParticipantID] = s
+	}
+
+	// Buchholz: 1 faced 3 (2 wins) and 5 (0) = 2; 2 faced 4 (1 win) and 6 (0) = 1
+	if byID[1].Buchholz != 2 || byID[2].Buchholz != 1 {
+		t.Errorf("expected Buchholz 2 and 1, got %v and %v", byID[1].Buchholz, byID[2].Buchholz)
+	}
+	if byID[1].Rank >= byID[2].Rank {
+		t.Errorf("expected participant 1 to rank above 2, got ranks %d and %d", byID[1].Rank, byID[2].Rank)
+	}
+
+	// Opponents of 1: 3 at 100%, 5 at 0% (floored to a third)
+	if want := (1 + minOpponentWinPercentage) / 2; byID[1].OpponentWinPercentage != want {
+		t.Errorf("expected opponent win percentage %f, got %f", want, byID[1].OpponentWinPercentage)
+	}
+}
+
+func TestStandings_ByeCountsAsWin(t *testing.T) {
+	p1 := uint64(1)
+	matches := []*domain.Match{
+		{BracketType: domain.BracketSwiss, Participant1ID: &p1, WinnerID: &p1, Status: domain.MatchCompleted},
+	}
+
+	standings := Standings(matches, DefaultSwissTiebreakers)
+	if standings[0].Wins != 1 || standings[0].Byes != 1 || standings[0].Played != 0 {
+		t.Errorf("expected a bye win with 0 played, got %+v", standings[0])
+	}
+}
+
+func TestStandings_DrawCountsAsHalfWin(t *testing.T) {
+	draw := playedMatch(2, 3)
+	draw.WinnerID = nil
+	draw.Draw = true
+
+	// 1 beats 2, 2 and 3 draw, 3 beats 4: 3 (1.5) ranks above 1 (1) and 2 (0.5)
+	matches := []*domain.Match{
+		playedMatch(1, 2, [2]int{2, 0}),
+		draw,
+		playedMatch(3, 4, [2]int{2, 0}),
+	}
+
+	standings := Standings(matches, nil)
+	if order := rankOrder(standings); !equalOrder(order, []uint64{3, 1, 2, 4}) {
+		t.Errorf("expected order [3 1 2 4], got %v", order)
+	}
+	for _, s := range standings {
+		if s.ParticipantID == 2 && (s.Draws != 1 || s.Losses != 1 || s.Played != 2) {
+			t.Errorf("expected participant 2 to have a draw and a loss in 2 played, got %+v", s)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// DefaultStreamSeeds is how high a participant must be seeded for their matches to be
+// featured on stream when no cutoff is set.
+const DefaultStreamSeeds = 4
+
+// StationQueue returns the ready matches waiting for a station, in the order they
+// should be played: earliest scheduled first (unscheduled matches last), then earlier
+// stages and rounds, then the order the matches were generated in. Matches already on
+// a station are left out.
+func StationQueue(matches []*domain.Match, stations []*domain.Station) []*domain.Match {
+	onStation := make(map[uint64]bool, len(stations))
+	for _, s := range stations {
+		if s.MatchID != nil {
+			onStation[*s.MatchID] = true
+		}
+	}
+
+	var queue []*domain.Match
+	for _, m := range matches {
+		if m.Status == domain.MatchReady && !onStation[m.ID] {
+			queue = append(queue, m)
+		}
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		a, b := queue[i], queue[j]
+		if (a.ScheduledAt == nil) != (b.ScheduledAt == nil) {
+			return a.ScheduledAt != nil
+		}
+		if a.ScheduledAt != nil && !a.ScheduledAt.Equal(*b.ScheduledAt) {
+			return a.ScheduledAt.Before(*b.ScheduledAt)
+		}
+		return playsFirst(a, b)
+	})
+	return queue
+}
+
+// AssignStations puts queued matches on free active stations and returns the
+// assignments as match IDs by station ID. The queue must be in priority order (see
+// StationQueue). Featured matches, those with a participant seeded streamSeeds or
+// better, take the free stream stations first; the other matches then fill the
+// remaining stations in queue order, stream stations last. A match is held back while
+// one of its participants is still playing: playing holds the matches that are on a
+// station or in progress.
+func AssignStations(stations []*domain.Station, queue []*domain.Match, playing []*domain.Match, streamSeeds int) map[uint64]uint64 {
+	busy := make(map[uint64]bool)
+	for _, m := range playing {
+		for _, id := range matchParticipants(m) {
+			busy[id] = true
+		}
+	}
+	available := func(m *domain.Match) bool {
+		for _, id := range matchParticipants(m) {
+			if busy[id] {
+				return false
+			}
+		}
+		return true
+	}
+
+	var stream, regular []*domain.Station
+	for _, s := range stations {
+		if !s.Active || s.MatchID != nil {
+			continue
+		}
+		if s.Stream {
+			stream = append(stream, s)
+		} else {
+			regular = append(regular, s)
+		}
+	}
+
+	assignments := make(map[uint64]uint64)
+	assigned := make(map[uint64]bool)
+	assign := func(s *domain.Station, m *domain.Match) {
+		assignments[s.ID] = m.ID
+		assigned[m.ID] = true
+		for _, id := range matchParticipants(m) {
+			busy[id] = true
+		}
+	}
+
+	for _, m := range queue {
+		if len(stream) == 0 {
+			break
+		}
+		if featured(m, streamSeeds) && available(m) {
+			assign(stream[0], m)
+			stream = stream[1:]
+		}
+	}
+
+	free := append(regular, stream...)
+	for _, m := range queue {
+		if len(free) == 0 {
+			break
+		}
+		if !assigned[m.ID] && available(m) {
+			assign(free[0], m)
+			free = free[1:]
+		}
+	}
+
+	return assignments
+}
+
+// featured reports whether a match has a participant seeded streamSeeds or better.
+func featured(m *domain.Match, streamSeeds int) bool {
+	for _, seed := range []*int{m.Seed1, m.Seed2} {
+		if seed != nil && *seed > 0 && *seed <= streamSeeds {
+			return true
+		}
+	}
+	for _, slot := range m.Slots {
+		if slot.Seed != nil && *slot.Seed > 0 && *slot.Seed <= streamSeeds {
+			return true
+		}
+	}
+	return false
+}
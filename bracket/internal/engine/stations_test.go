@@ -0,0 +1,77 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func readyMatch(id, p1, p2 uint64, seed1, seed2 int) *domain.Match {
+	return &domain.Match{
+		ID:             id,
+		Round:          1,
+		Participant1ID: &p1,
+		Participant2ID: &p2,
+		Seed1:          &seed1,
+		Seed2:          &seed2,
+		Status:         domain.MatchReady,
+	}
+}
+
+func TestStationQueue_Order(t *testing.T) {
+	early := time.Date(2025, 6, 7, 10, 0, 0, 0, time.UTC)
+	late := early.Add(time.Hour)
+
+	a := readyMatch(1, 1, 2, 1, 2)
+	a.ScheduledAt = &late
+	b := readyMatch(2, 3, 4, 3, 4)
+	b.ScheduledAt = &early
+	c := readyMatch(3, 5, 6, 5, 6) // Unscheduled
+	d := readyMatch(4, 7, 8, 7, 8)
+	d.ScheduledAt = &early
+	onStation := readyMatch(5, 9, 10, 9, 10)
+	pending := &domain.Match{ID: 6, Status: domain.MatchPending}
+
+	matchID := onStation.ID
+	stations := []*domain.Station{{ID: 1, Active: true, MatchID: &matchID}}
+	queue := StationQueue([]*domain.Match{a, b, c, d, onStation, pending}, stations)
+
+	var order []uint64
+	for _, m := range queue {
+		order = append(order, m.ID)
+	}
+	if !equalOrder(order, []uint64{2, 4, 1, 3}) {
+		t.Errorf("expected queue [2 4 1 3], got %v", order)
+	}
+}
+
+func TestAssignStations(t *testing.T) {
+	queue := []*domain.Match{
+		readyMatch(1, 5, 6, 5, 6),
+		readyMatch(2, 7, 8, 7, 8), // Participant 8 is still playing elsewhere
+		readyMatch(3, 1, 9, 1, 9),
+		readyMatch(4, 2, 10, 2, 10),
+	}
+	busyID := uint64(8)
+	playing := []*domain.Match{{ID: 9, Participant1ID: &busyID, Status: domain.MatchInProgress}}
+	stations := []*domain.Station{
+		{ID: 1, Active: true},
+		{ID: 2, Active: true, Stream: true},
+		{ID: 3, Active: false},
+		{ID: 4, Active: true},
+	}
+
+	assignments := AssignStations(stations, queue, playing, 2)
+
+	// The first featured match takes the stream; the others fill the setups in queue order
+	want := map[uint64]uint64{2: 3, 1: 1, 4: 4}
+	if len(assignments) != len(want) {
+		t.Fatalf("expected %d assignments, got %v", len(want), assignments)
+	}
+	for stationID, matchID := range want {
+		if assignments[stationID] != matchID {
+			t.Errorf("station %d: expected match %d, got %d", stationID, matchID, assignments[stationID])
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+var ErrRoundInProgress = errors.New("current round still has unfinished matches")
+
+// swissPairingBudget caps the pairing search so a field where rematches cannot be
+// avoided falls back quickly instead of exploring every combination.
+const swissPairingBudget = 100000
+
+// SwissRounds returns the default number of Swiss rounds for a field: enough rounds
+// for a single undefeated player to remain, as in a single elimination bracket.
+func SwissRounds(participantCount int) int {
+	if participantCount < 2 {
+		return 0
+	}
+	return TotalRounds(CalculateBracketSize(participantCount))
+}
+
+// SwissRound pairs the next round of a Swiss event from the match history. Unlike the
+// other formats it only generates one round at a time, and every earlier Swiss match
+// must be completed first.
+//
+//   - Players are ranked by wins (a draw is half a win), then seed. Within a score
+//     group the top half plays the bottom half (1 vs 5, 2 vs 6, ... in a group of 8);
+//     an odd player out floats down to the next group.
+//   - Rematches are avoided whenever a pairing without them exists.
+//   - With an odd field the lowest ranked player who has not had a bye gets one.
+//     A bye is a completed match with one participant and counts as a win.
+func SwissRound(tournamentID uint64, participants []domain.Participant, history []*domain.Match) ([]*domain.Match, error) {
+	if len(participants) < 2 {
+		return nil, fmt.Errorf("need at least 2 participants, got %d", len(participants))
+	}
+
+	round := 1
+	wins := make(map[uint64]int)
+	hadBye := make(map[uint64]bool)
+	played := make(map[[2]uint64]bool)
+	for _, m := range history {
+		if m.BracketType != domain.BracketSwiss {
+			continue
+		}
+		if m.Status != domain.MatchCompleted {
+			return nil, ErrRoundInProgress
+		}
+		if m.Round >= round {
+			round = m.Round + 1
+		}
+		// Counted in half wins, so a draw is worth one to each player
+		switch {
+		case m.WinnerID != nil:
+			wins[*m.WinnerID] += 2
+		case m.Draw:
+			wins[*m.Participant1ID]++
+			wins[*m.Participant2ID]++
+		}
+		switch {
+		case m.Participant1ID != nil && m.Participant2ID != nil:
+			played[pairKey(*m.Participant1ID, *m.Participant2ID)] = true
+		case m.Participant1ID != nil:
+			hadBye[*m.Participant1ID] = true
+		}
+	}
+
+	// Rank by record, then seed
+	sorted := make([]domain.Participant, len(participants))
+	copy(sorted, participants)
+	sort.Slice(sorted, func(i, j int) bool {
+		if wins[sorted[i].ID] != wins[sorted[j].ID] {
+			return wins[sorted[i].ID] > wins[sorted[j].ID]
+		}
+		if sorted[i].Seed != sorted[j].Seed {
+			return sorted[i].Seed < sorted[j].Seed
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	pool := make([]*domain.Participant, len(sorted))
+	for i := range sorted {
+		pool[i] = &sorted[i]
+	}
+
+	// Lowest ranked player without a bye sits out
+	var bye *domain.Participant
+	if len(pool)%2 == 1 {
+		idx := len(pool) - 1
+		for i := len(pool) - 1; i >= 0; i-- {
+			if !hadBye[pool[i].ID] {
+				idx = i
+				break
+			}
+		}
+		bye = pool[idx]
+		pool = append(pool[:idx:idx], pool[idx+1:]...)
+	}
+
+	budget := swissPairingBudget
+	pairs, ok := pairSwiss(pool, wins, played, &budget)
+	if !ok {
+		// Every pairing needs a rematch; pair by rank alone
+		pairs, _ = pairSwiss(pool, wins, nil, nil)
+	}
+
+	var matches []*domain.Match
+	for i, pair := range pairs {
+		match := createMatch(tournamentID, round, i+1, pair[0], pair[1])
+		match.BracketType = domain.BracketSwiss
+		matches = append(matches, match)
+	}
+	if bye != nil {
+		match := createMatch(tournamentID, round, len(pairs)+1, bye, nil)
+		match.BracketType = domain.BracketSwiss
+		processBye(match)
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
+
+// pairSwiss pairs a ranked pool of players, backtracking to avoid the pairs in played.
+// budget limits the number of pairings tried; nil means unlimited.
+func pairSwiss(pool []*domain.Participant, wins map[uint64]int, played map[[2]uint64]bool, budget *int) ([][2]*domain.Participant, bool) {
+	if len(pool) == 0 {
+		return nil, true
+	}
+
+	top, rest := pool[0], pool[1:]
+	for _, i := range swissCandidates(top, rest, wins) {
+		opponent := rest[i]
+		if played[pairKey(top.ID, opponent.ID)] {
+			continue
+		}
+		if budget != nil {
+			if *budget <= 0 {
+				return nil, false
+			}
+			*budget--
+		}
+
+		remaining := make([]*domain.Participant, 0, len(rest)-1)
+		remaining = append(remaining, rest[:i]...)
+		remaining = append(remaining, rest[i+1:]...)
+		if pairs, ok := pairSwiss(remaining, wins, played, budget); ok {
+			return append([][2]*domain.Participant{{top, opponent}}, pairs...), true
+		}
+	}
+
+	return nil, false
+}
+
+// swissCandidates returns the indexes of rest in the order top should try them as
+// opponents: the player half a score group below top first, then the rest of the
+// group, then lower score groups in rank order.
+func swissCandidates(top *domain.Participant, rest []*domain.Participant, wins map[uint64]int) []int {
+	groupSize := 0
+	for groupSize < len(rest) && wins[rest[groupSize].ID] == wins[top.ID] {
+		groupSize++
+	}
+
+	// Index in rest of the player half the group (top included) below top
+	start := (groupSize+1)/2 - 1
+	if start < 0 {
+		start = 0
+	}
+
+	order := make([]int, 0, len(rest))
+	for i := start; i < groupSize; i++ {
+		order = append(order, i)
+	}
+	for i := 0; i < start; i++ {
+		order = append(order, i)
+	}
+	for i := groupSize; i < len(rest); i++ {
+		order = append(order, i)
+	}
+	return order
+}
+
+// pairKey returns an order-independent key for a pair of participants.
+func pairKey(a, b uint64) [2]uint64 {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]uint64{a, b}
+}
+
+// SwissParticipants returns the participants that appear in a Swiss match history,
+// ordered by seed.
+func SwissParticipants(history []*domain.Match) []domain.Participant {
+	seen := make(map[uint64]bool)
+	var participants []domain.Participant
+	add := func(id *uint64, name *string, seed *int) {
+		if id == nil || seen[*id] {
+			return
+		}
+		seen[*id] = true
+		p := domain.Participant{ID: *id}
+		if name != nil {
+			p.Name = *name
+		}
+		if seed != nil {
+			p.Seed = *seed
+		}
+		participants = append(participants, p)
+	}
+
+	for _, m := range history {
+		if m.BracketType != domain.BracketSwiss {
+			continue
+		}
+		add(m.Participant1ID, m.Participant1Name, m.Seed1)
+		add(m.Participant2ID, m.Participant2Name, m.Seed2)
+	}
+
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].Seed < participants[j].Seed
+	})
+	return participants
+}
+
+// IsSwiss reports whether the matches form a Swiss event.
+func IsSwiss(matches []*domain.Match) bool {
+	if len(matches) == 0 {
+		return false
+	}
+	for _, m := range matches {
+		if m.BracketType != domain.BracketSwiss {
+			return false
+		}
+	}
+	return true
+}
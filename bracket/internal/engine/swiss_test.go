@@ -0,0 +1,160 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// playSwissRound completes every unplayed match, with the lower seed winning.
+func playSwissRound(matches []*domain.Match) {
+	for _, m := range matches {
+		if m.Status == domain.MatchCompleted {
+			continue
+		}
+		winner := m.Participant1ID
+		if *m.Seed2 < *m.Seed1 {
+			winner = m.Participant2ID
+		}
+		m.WinnerID = winner
+		m.Status = domain.MatchCompleted
+	}
+}
+
+func TestSwissRounds(t *testing.T) {
+	tests := []struct {
+		participants int
+		want         int
+	}{
+		{1, 0},
+		{2, 1},
+		{5, 3},
+		{8, 3},
+		{9, 4},
+		{32, 5},
+	}
+
+	for _, tt := range tests {
+		got := SwissRounds(tt.participants)
+		if got != tt.want {
+			t.Errorf("SwissRounds(%d) = %d, want %d", tt.participants, got, tt.want)
+		}
+	}
+}
+
+func TestSwissRound_FirstRoundFoldsField(t *testing.T) {
+	matches, err := SwissRound(1, makeParticipants(8), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := [][2]int{{1, 5}, {2, 6}, {3, 7}, {4, 8}}
+	if len(matches) != len(expected) {
+		t.Fatalf("expected %d matches, got %d", len(expected), len(matches))
+	}
+	for i, m := range matches {
+		if m.BracketType != domain.BracketSwiss || m.Round != 1 || m.Status != domain.MatchReady {
+			t.Errorf("match %d: unexpected type/round/status %s/%d/%s", i+1, m.BracketType, m.Round, m.Status)
+		}
+		if *m.Seed1 != expected[i][0] || *m.Seed2 != expected[i][1] {
+			t.Errorf("match %d: expected seeds %v, got %d vs %d", i+1, expected[i], *m.Seed1, *m.Seed2)
+		}
+	}
+}
+
+func TestSwissRound_PairsByRecordWithoutRematches(t *testing.T) {
+	participants := makeParticipants(8)
+	var history []*domain.Match
+	played := make(map[[2]uint64]bool)
+
+	for round := 1; round <= 3; round++ {
+		matches, err := SwissRound(1, participants, history)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %v", round, err)
+		}
+
+		wins := make(map[uint64]int)
+		for _, m := range history {
+			wins[*m.WinnerID]++
+		}
+
+		for _, m := range matches {
+			if m.Round != round {
+				t.Errorf("expected round %d, got %d", round, m.Round)
+			}
+			key := pairKey(*m.Participant1ID, *m.Participant2ID)
+			if played[key] {
+				t.Errorf("round %d: rematch between %v", round, key)
+			}
+			played[key] = true
+			if wins[*m.Participant1ID] != wins[*m.Participant2ID] {
+				t.Errorf("round %d: %d (%d wins) paired with %d (%d wins)", round,
+					*m.Participant1ID, wins[*m.Participant1ID], *m.Participant2ID, wins[*m.Participant2ID])
+			}
+		}
+
+		playSwissRound(matches)
+		history = append(history, matches...)
+	}
+}
+
+func TestSwissRound_OneByePerPlayer(t *testing.T) {
+	participants := makeParticipants(5)
+	var history []*domain.Match
+	byes := make(map[uint64]int)
+
+	for round := 1; round <= 5; round++ {
+		matches, err := SwissRound(1, participants, history)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %v", round, err)
+		}
+
+		roundByes := 0
+		for _, m := range matches {
+			if m.Participant2ID == nil {
+				roundByes++
+				byes[*m.Participant1ID]++
+				if m.Status != domain.MatchCompleted || m.WinnerID == nil || *m.WinnerID != *m.Participant1ID {
+					t.Errorf("round %d: bye should be completed and won by its participant", round)
+				}
+			}
+		}
+		if roundByes != 1 {
+			t.Errorf("round %d: expected 1 bye, got %d", round, roundByes)
+		}
+
+		playSwissRound(matches)
+		history = append(history, matches...)
+	}
+
+	for id, n := range byes {
+		if n > 1 {
+			t.Errorf("participant %d received %d byes", id, n)
+		}
+	}
+}
+
+func TestSwissRound_RoundInProgress(t *testing.T) {
+	participants := makeParticipants(4)
+	history, _ := SwissRound(1, participants, nil)
+
+	_, err := SwissRound(1, participants, history)
+	if !errors.Is(err, ErrRoundInProgress) {
+		t.Errorf("expected ErrRoundInProgress, got %v", err)
+	}
+}
+
+func TestSwissParticipants(t *testing.T) {
+	matches, _ := SwissRound(1, makeParticipants(5), nil)
+
+	participants := SwissParticipants(matches)
+	if len(participants) != 5 {
+		t.Fatalf("expected 5 participants, got %d", len(participants))
+	}
+	for i, p := range participants {
+		if p.Seed != i+1 {
+			t.Errorf("expected seed %d at index %d, got %d", i+1, i, p.Seed)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+type EventRepository interface {
+	Create(ctx context.Context, event *domain.MatchEvent) error
+	GetByMatchID(ctx context.Context, matchID uint64) ([]domain.MatchEvent, error)
+	GetByTournament(ctx context.Context, tournamentID uint64) ([]domain.MatchEvent, error)
+}
+
+type eventRepository struct {
+	db *sql.DB
+}
+
+func NewEventRepository(db *sql.DB) EventRepository {
+	return &eventRepository{db: db}
+}
+
+func (r *eventRepository) Create(ctx context.Context, event *domain.MatchEvent) error {
+	before, err := json.Marshal(event.Before)
+	if err != nil {
+		return err
+	}
+	after, err := json.Marshal(event.After)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO match_events (tournament_id, match_id, type, user_id, reason, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+	return r.db.QueryRowContext(ctx, query,
+		event.TournamentID, event.MatchID, event.Type, event.UserID, event.Reason, before, after,
+	).Scan(&event.ID, &event.CreatedAt)
+}
+
+// GetByMatchID returns a match's events, oldest first.
+func (r *eventRepository) GetByMatchID(ctx context.Context, matchID uint64) ([]domain.MatchEvent, error) {
+	query := `
+		SELECT id, tournament_id, match_id, type, user_id, reason, before, after, created_at
+		FROM match_events
+		WHERE match_id = $1
+		ORDER BY created_at, id
+	`
+	return r.query(ctx, query, matchID)
+}
+
+// GetByTournament returns the events of every match in a tournament, oldest first.
+func (r *eventRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]domain.MatchEvent, error) {
+	query := `
+		SELECT id, tournament_id, match_id, type, user_id, reason, before, after, created_at
+		FROM match_events
+		WHERE tournament_id = $1
+		ORDER BY created_at, id
+	`
+	return r.query(ctx, query, tournamentID)
+}
+
+func (r *eventRepository) query(ctx context.Context, query string, args ...interface{}) ([]domain.MatchEvent, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []domain.MatchEvent
+	for rows.Next() {
+		var e domain.MatchEvent
+		var before, after []byte
+		err := rows.Scan(
+			&e.ID, &e.TournamentID, &e.MatchID, &e.Type, &e.UserID, &e.Reason, &before, &after, &e.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(before, &e.Before); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(after, &e.After); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
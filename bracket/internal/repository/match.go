@@ -4,11 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/braccet/bracket/internal/domain"
 )
 
-var ErrMatchNotFound = errors.New("match not found")
+var (
+	ErrMatchNotFound      = errors.New("match not found")
+	ErrMatchStatusChanged = errors.New("match is no longer in the expected status")
+)
 
 type MatchRepository interface {
 	CreateBatch(ctx context.Context, matches []*domain.Match) error
@@ -18,10 +22,20 @@ type MatchRepository interface {
 	UpdateResult(ctx context.Context, matchID uint64, winnerID uint64) error
 	UpdateStatus(ctx context.Context, matchID uint64, status domain.MatchStatus) error
 	UpdateForfeit(ctx context.Context, matchID uint64, winnerID uint64) error
+	CompleteWithoutWinner(ctx context.Context, matchID uint64) error
+	UpdateDraw(ctx context.Context, matchID uint64) error
 	SetParticipant(ctx context.Context, matchID uint64, slot int, participantID uint64, name string, seed int) error
 	UpdateNextMatchLinks(ctx context.Context, matches []*domain.Match) error
+	UpdateSchedule(ctx context.Context, schedule map[uint64]time.Time) error
+	CallMatch(ctx context.Context, matchID uint64, deadline time.Time) error
+	CheckIn(ctx context.Context, matchID uint64, slot int) (checkedIn1, checkedIn2 bool, err error)
+	GetExpiredCalls(ctx context.Context, now time.Time) ([]*domain.Match, error)
 	ReopenMatch(ctx context.Context, matchID uint64) error
 	ClearParticipant(ctx context.Context, matchID uint64, slot int) error
+	Delete(ctx context.Context, matchID uint64) error
+	SetDisqualified(ctx context.Context, matchID uint64, slot int) error
+	SetWithdrawn(ctx context.Context, matchID uint64, slot int) error
+	ReplaceByTournament(ctx context.Context, tournamentID uint64, byes []uint64, matches []*domain.Match) error
 }
 
 type matchRepository struct {
@@ -41,8 +55,8 @@ func (r *matchRepository) CreateBatch(ctx context.Context, matches []*domain.Mat
 	defer tx.Rollback()
 
 	query := `
-		INSERT INTO matches (tournament_id, bracket_type, round, position, participant1_id, participant2_id, participant1_name, participant2_name, seed1, seed2, status, scheduled_at, next_match_id, loser_match_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		INSERT INTO matches (tournament_id, stage, pool, bracket_type, round, position, participant1_id, participant2_id, participant1_name, participant2_name, seed1, seed2, winner_id, status, scheduled_at, next_match_id, loser_match_id, advance_count, best_of, sets_to_win, allow_tied_sets, scoring_mode)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
 		RETURNING id
 	`
 	stmt, err := tx.PrepareContext(ctx, query)
@@ -53,9 +67,10 @@ func (r *matchRepository) CreateBatch(ctx context.Context, matches []*domain.Mat
 
 	for _, m := range matches {
 		err := stmt.QueryRowContext(ctx,
-			m.TournamentID, m.BracketType, m.Round, m.Position,
+			m.TournamentID, m.Stage, m.Pool, m.BracketType, m.Round, m.Position,
 			m.Participant1ID, m.Participant2ID, m.Participant1Name, m.Participant2Name,
-			m.Seed1, m.Seed2, m.Status, m.ScheduledAt, m.NextMatchID, m.LoserMatchID,
+			m.Seed1, m.Seed2, m.WinnerID, m.Status, m.ScheduledAt, m.NextMatchID, m.LoserMatchID, m.AdvanceCount,
+			m.Format.BestOf, m.Format.SetsToWin, m.Format.AllowTiedSets, scoringMode(m.Format.Scoring),
 		).Scan(&m.ID)
 		if err != nil {
 			return err
@@ -67,20 +82,27 @@ func (r *matchRepository) CreateBatch(ctx context.Context, matches []*domain.Mat
 
 func (r *matchRepository) GetByID(ctx context.Context, id uint64) (*domain.Match, error) {
 	query := `
-		SELECT id, tournament_id, bracket_type, round, position,
+		SELECT id, tournament_id, stage, pool, bracket_type, round, position,
 		       participant1_id, participant2_id, participant1_name, participant2_name,
 		       seed1, seed2, winner_id, status, scheduled_at, completed_at, next_match_id, loser_match_id,
-		       forfeit_winner_id, created_at, updated_at
+		       forfeit_winner_id, COALESCE(participant1_disqualified, FALSE), COALESCE(participant2_disqualified, FALSE),
+		       participant1_withdrawn, participant2_withdrawn,
+		       advance_count, best_of, sets_to_win, allow_tied_sets, scoring_mode, draw,
+		       check_in_deadline, participant1_checked_in, participant2_checked_in, created_at, updated_at
 		FROM matches
 		WHERE id = $1
 	`
 	m := &domain.Match{}
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&m.ID, &m.TournamentID, &m.BracketType, &m.Round, &m.Position,
+		&m.ID, &m.TournamentID, &m.Stage, &m.Pool, &m.BracketType, &m.Round, &m.Position,
 		&m.Participant1ID, &m.Participant2ID, &m.Participant1Name, &m.Participant2Name,
 		&m.Seed1, &m.Seed2, &m.WinnerID, &m.Status,
 		&m.ScheduledAt, &m.CompletedAt, &m.NextMatchID, &m.LoserMatchID,
-		&m.ForfeitWinnerID, &m.CreatedAt, &m.UpdatedAt,
+		&m.ForfeitWinnerID, &m.Participant1Disqualified, &m.Participant2Disqualified,
+		&m.Participant1Withdrawn, &m.Participant2Withdrawn,
+		&m.AdvanceCount, &m.Format.BestOf, &m.Format.SetsToWin, &m.Format.AllowTiedSets, &m.Format.Scoring, &m.Draw,
+		&m.CheckInDeadline, &m.Participant1CheckedIn, &m.Participant2CheckedIn,
+		&m.CreatedAt, &m.UpdatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -94,13 +116,16 @@ func (r *matchRepository) GetByID(ctx context.Context, id uint64) (*domain.Match
 
 func (r *matchRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Match, error) {
 	query := `
-		SELECT id, tournament_id, bracket_type, round, position,
+		SELECT id, tournament_id, stage, pool, bracket_type, round, position,
 		       participant1_id, participant2_id, participant1_name, participant2_name,
 		       seed1, seed2, winner_id, status, scheduled_at, completed_at, next_match_id, loser_match_id,
-		       forfeit_winner_id, created_at, updated_at
+		       forfeit_winner_id, COALESCE(participant1_disqualified, FALSE), COALESCE(participant2_disqualified, FALSE),
+		       participant1_withdrawn, participant2_withdrawn,
+		       advance_count, best_of, sets_to_win, allow_tied_sets, scoring_mode, draw,
+		       check_in_deadline, participant1_checked_in, participant2_checked_in, created_at, updated_at
 		FROM matches
 		WHERE tournament_id = $1
-		ORDER BY bracket_type, round, position
+		ORDER BY stage, pool, bracket_type, round, position
 	`
 	rows, err := r.db.QueryContext(ctx, query, tournamentID)
 	if err != nil {
@@ -112,11 +137,15 @@ func (r *matchRepository) GetByTournament(ctx context.Context, tournamentID uint
 	for rows.Next() {
 		m := &domain.Match{}
 		err := rows.Scan(
-			&m.ID, &m.TournamentID, &m.BracketType, &m.Round, &m.Position,
+			&m.ID, &m.TournamentID, &m.Stage, &m.Pool, &m.BracketType, &m.Round, &m.Position,
 			&m.Participant1ID, &m.Participant2ID, &m.Participant1Name, &m.Participant2Name,
 			&m.Seed1, &m.Seed2, &m.WinnerID, &m.Status,
 			&m.ScheduledAt, &m.CompletedAt, &m.NextMatchID, &m.LoserMatchID,
-			&m.ForfeitWinnerID, &m.CreatedAt, &m.UpdatedAt,
+			&m.ForfeitWinnerID, &m.Participant1Disqualified, &m.Participant2Disqualified,
+			&m.Participant1Withdrawn, &m.Participant2Withdrawn,
+			&m.AdvanceCount, &m.Format.BestOf, &m.Format.SetsToWin, &m.Format.AllowTiedSets, &m.Format.Scoring, &m.Draw,
+			&m.CheckInDeadline, &m.Participant1CheckedIn, &m.Participant2CheckedIn,
+			&m.CreatedAt, &m.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -134,7 +163,7 @@ func (r *matchRepository) GetByTournament(ctx context.Context, tournamentID uint
 func (r *matchRepository) UpdateResult(ctx context.Context, matchID uint64, winnerID uint64) error {
 	query := `
 		UPDATE matches
-		SET winner_id = $1, forfeit_winner_id = NULL, status = $2, completed_at = NOW()
+		SET winner_id = $1, forfeit_winner_id = NULL, status = $2, completed_at = NOW(), draw = FALSE
 		WHERE id = $3
 	`
 	res, err := r.db.ExecContext(ctx, query, winnerID, domain.MatchCompleted, matchID)
@@ -171,12 +200,14 @@ func (r *matchRepository) UpdateStatus(ctx context.Context, matchID uint64, stat
 	return nil
 }
 
+// SetParticipant puts a participant in the given slot (1 or 2) of a match. The new
+// occupant of a slot has not checked in, even if the one it replaces had.
 func (r *matchRepository) SetParticipant(ctx context.Context, matchID uint64, slot int, participantID uint64, name string, seed int) error {
 	var query string
 	if slot == 1 {
-		query = `UPDATE matches SET participant1_id = $1, participant1_name = $2, seed1 = $3 WHERE id = $4`
+		query = `UPDATE matches SET participant1_id = $1, participant1_name = $2, seed1 = $3, participant1_checked_in = FALSE WHERE id = $4`
 	} else {
-		query = `UPDATE matches SET participant2_id = $1, participant2_name = $2, seed2 = $3 WHERE id = $4`
+		query = `UPDATE matches SET participant2_id = $1, participant2_name = $2, seed2 = $3, participant2_checked_in = FALSE WHERE id = $4`
 	}
 
 	res, err := r.db.ExecContext(ctx, query, participantID, name, seed, matchID)
@@ -206,15 +237,140 @@ func (r *matchRepository) UpdateNextMatchLinks(ctx context.Context, matches []*d
 	return nil
 }
 
+// UpdateSchedule sets the scheduled start time of each match in the schedule, keyed by match ID.
+func (r *matchRepository) UpdateSchedule(ctx context.Context, schedule map[uint64]time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `UPDATE matches SET scheduled_at = $1 WHERE id = $2`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for matchID, scheduledAt := range schedule {
+		if _, err := stmt.ExecContext(ctx, scheduledAt, matchID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// CallMatch marks a ready match as called, with no one checked in yet. It returns
+// ErrMatchStatusChanged if no ready match has the ID, e.g. because it was called or
+// started meanwhile.
+func (r *matchRepository) CallMatch(ctx context.Context, matchID uint64, deadline time.Time) error {
+	query := `
+		UPDATE matches
+		SET status = $1, check_in_deadline = $2, participant1_checked_in = FALSE, participant2_checked_in = FALSE
+		WHERE id = $3 AND status = $4
+	`
+	res, err := r.db.ExecContext(ctx, query, domain.MatchCalled, deadline, matchID, domain.MatchReady)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrMatchStatusChanged
+	}
+
+	return nil
+}
+
+// CheckIn records that the participant in the given slot (1 or 2) of a called match is
+// present, and returns which participants have checked in now. Both flags are read
+// back in the same statement, so of two participants checking in at once, the second
+// sees the first. It returns ErrMatchStatusChanged if no called match has the ID.
+func (r *matchRepository) CheckIn(ctx context.Context, matchID uint64, slot int) (bool, bool, error) {
+	var query string
+	if slot == 1 {
+		query = `UPDATE matches SET participant1_checked_in = TRUE WHERE id = $1 AND status = $2
+			RETURNING participant1_checked_in, participant2_checked_in`
+	} else {
+		query = `UPDATE matches SET participant2_checked_in = TRUE WHERE id = $1 AND status = $2
+			RETURNING participant1_checked_in, participant2_checked_in`
+	}
+
+	var checkedIn1, checkedIn2 bool
+	err := r.db.QueryRowContext(ctx, query, matchID, domain.MatchCalled).Scan(&checkedIn1, &checkedIn2)
+	if err == sql.ErrNoRows {
+		return false, false, ErrMatchStatusChanged
+	}
+	if err != nil {
+		return false, false, err
+	}
+
+	return checkedIn1, checkedIn2, nil
+}
+
+// GetExpiredCalls returns called matches of every tournament whose check-in deadline
+// has passed.
+func (r *matchRepository) GetExpiredCalls(ctx context.Context, now time.Time) ([]*domain.Match, error) {
+	query := `
+		SELECT id, tournament_id, stage, pool, bracket_type, round, position,
+		       participant1_id, participant2_id, participant1_name, participant2_name,
+		       seed1, seed2, winner_id, status, scheduled_at, completed_at, next_match_id, loser_match_id,
+		       forfeit_winner_id, COALESCE(participant1_disqualified, FALSE), COALESCE(participant2_disqualified, FALSE),
+		       participant1_withdrawn, participant2_withdrawn,
+		       advance_count, best_of, sets_to_win, allow_tied_sets, scoring_mode, draw,
+		       check_in_deadline, participant1_checked_in, participant2_checked_in, created_at, updated_at
+		FROM matches
+		WHERE status = 'called' AND check_in_deadline <= $1
+		ORDER BY check_in_deadline
+	`
+	rows, err := r.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []*domain.Match
+	for rows.Next() {
+		m := &domain.Match{}
+		err := rows.Scan(
+			&m.ID, &m.TournamentID, &m.Stage, &m.Pool, &m.BracketType, &m.Round, &m.Position,
+			&m.Participant1ID, &m.Participant2ID, &m.Participant1Name, &m.Participant2Name,
+			&m.Seed1, &m.Seed2, &m.WinnerID, &m.Status,
+			&m.ScheduledAt, &m.CompletedAt, &m.NextMatchID, &m.LoserMatchID,
+			&m.ForfeitWinnerID, &m.Participant1Disqualified, &m.Participant2Disqualified,
+			&m.Participant1Withdrawn, &m.Participant2Withdrawn,
+			&m.AdvanceCount, &m.Format.BestOf, &m.Format.SetsToWin, &m.Format.AllowTiedSets, &m.Format.Scoring, &m.Draw,
+			&m.CheckInDeadline, &m.Participant1CheckedIn, &m.Participant2CheckedIn,
+			&m.CreatedAt, &m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
 func (r *matchRepository) GetPendingByParticipant(ctx context.Context, tournamentID, participantID uint64) ([]*domain.Match, error) {
 	query := `
-		SELECT id, tournament_id, bracket_type, round, position,
+		SELECT id, tournament_id, stage, pool, bracket_type, round, position,
 		       participant1_id, participant2_id, participant1_name, participant2_name,
 		       seed1, seed2, winner_id, status, scheduled_at, completed_at, next_match_id, loser_match_id,
-		       forfeit_winner_id, created_at, updated_at
+		       forfeit_winner_id, COALESCE(participant1_disqualified, FALSE), COALESCE(participant2_disqualified, FALSE),
+		       participant1_withdrawn, participant2_withdrawn,
+		       advance_count, best_of, sets_to_win, allow_tied_sets, scoring_mode, draw,
+		       check_in_deadline, participant1_checked_in, participant2_checked_in, created_at, updated_at
 		FROM matches
 		WHERE tournament_id = $1
-		  AND status IN ('pending', 'ready', 'in_progress')
+		  AND status IN ('pending', 'ready', 'called', 'in_progress', 'disputed')
 		  AND (participant1_id = $2 OR participant2_id = $2)
 		ORDER BY round ASC
 	`
@@ -228,11 +384,15 @@ func (r *matchRepository) GetPendingByParticipant(ctx context.Context, tournamen
 	for rows.Next() {
 		m := &domain.Match{}
 		err := rows.Scan(
-			&m.ID, &m.TournamentID, &m.BracketType, &m.Round, &m.Position,
+			&m.ID, &m.TournamentID, &m.Stage, &m.Pool, &m.BracketType, &m.Round, &m.Position,
 			&m.Participant1ID, &m.Participant2ID, &m.Participant1Name, &m.Participant2Name,
 			&m.Seed1, &m.Seed2, &m.WinnerID, &m.Status,
 			&m.ScheduledAt, &m.CompletedAt, &m.NextMatchID, &m.LoserMatchID,
-			&m.ForfeitWinnerID, &m.CreatedAt, &m.UpdatedAt,
+			&m.ForfeitWinnerID, &m.Participant1Disqualified, &m.Participant2Disqualified,
+			&m.Participant1Withdrawn, &m.Participant2Withdrawn,
+			&m.AdvanceCount, &m.Format.BestOf, &m.Format.SetsToWin, &m.Format.AllowTiedSets, &m.Format.Scoring, &m.Draw,
+			&m.CheckInDeadline, &m.Participant1CheckedIn, &m.Participant2CheckedIn,
+			&m.CreatedAt, &m.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -250,7 +410,7 @@ func (r *matchRepository) GetPendingByParticipant(ctx context.Context, tournamen
 func (r *matchRepository) UpdateForfeit(ctx context.Context, matchID uint64, winnerID uint64) error {
 	query := `
 		UPDATE matches
-		SET winner_id = $1, forfeit_winner_id = $1, status = 'completed', completed_at = NOW()
+		SET winner_id = $1, forfeit_winner_id = $1, status = 'completed', completed_at = NOW(), draw = FALSE
 		WHERE id = $2
 	`
 	res, err := r.db.ExecContext(ctx, query, winnerID, matchID)
@@ -269,10 +429,58 @@ func (r *matchRepository) UpdateForfeit(ctx context.Context, matchID uint64, win
 	return nil
 }
 
+// CompleteWithoutWinner marks a match completed with no winner, e.g. a losers bracket
+// match whose feeders were both byes.
+func (r *matchRepository) CompleteWithoutWinner(ctx context.Context, matchID uint64) error {
+	query := `
+		UPDATE matches
+		SET winner_id = NULL, forfeit_winner_id = NULL, status = $1, completed_at = NOW(), draw = FALSE
+		WHERE id = $2
+	`
+	res, err := r.db.ExecContext(ctx, query, domain.MatchCompleted, matchID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrMatchNotFound
+	}
+
+	return nil
+}
+
+// UpdateDraw marks a match completed as a draw, with no winner.
+func (r *matchRepository) UpdateDraw(ctx context.Context, matchID uint64) error {
+	query := `
+		UPDATE matches
+		SET winner_id = NULL, forfeit_winner_id = NULL, draw = TRUE, status = $1, completed_at = NOW()
+		WHERE id = $2
+	`
+	res, err := r.db.ExecContext(ctx, query, domain.MatchCompleted, matchID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrMatchNotFound
+	}
+
+	return nil
+}
+
 func (r *matchRepository) ReopenMatch(ctx context.Context, matchID uint64) error {
 	query := `
 		UPDATE matches
-		SET winner_id = NULL, forfeit_winner_id = NULL, status = $1, completed_at = NULL
+		SET winner_id = NULL, forfeit_winner_id = NULL, status = $1, completed_at = NULL, draw = FALSE,
+		    check_in_deadline = NULL, participant1_checked_in = FALSE, participant2_checked_in = FALSE
 		WHERE id = $2
 	`
 	res, err := r.db.ExecContext(ctx, query, domain.MatchReady, matchID)
@@ -294,9 +502,9 @@ func (r *matchRepository) ReopenMatch(ctx context.Context, matchID uint64) error
 func (r *matchRepository) ClearParticipant(ctx context.Context, matchID uint64, slot int) error {
 	var query string
 	if slot == 1 {
-		query = `UPDATE matches SET participant1_id = NULL, participant1_name = NULL WHERE id = $1`
+		query = `UPDATE matches SET participant1_id = NULL, participant1_name = NULL, participant1_disqualified = FALSE, participant1_withdrawn = FALSE WHERE id = $1`
 	} else {
-		query = `UPDATE matches SET participant2_id = NULL, participant2_name = NULL WHERE id = $1`
+		query = `UPDATE matches SET participant2_id = NULL, participant2_name = NULL, participant2_disqualified = FALSE, participant2_withdrawn = FALSE WHERE id = $1`
 	}
 
 	res, err := r.db.ExecContext(ctx, query, matchID)
@@ -314,3 +522,203 @@ func (r *matchRepository) ClearParticipant(ctx context.Context, matchID uint64,
 
 	return nil
 }
+
+func (r *matchRepository) Delete(ctx context.Context, matchID uint64) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM matches WHERE id = $1", matchID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrMatchNotFound
+	}
+
+	return nil
+}
+
+// SetDisqualified flags the participant in the given slot (1 or 2) as disqualified.
+func (r *matchRepository) SetDisqualified(ctx context.Context, matchID uint64, slot int) error {
+	var query string
+	if slot == 1 {
+		query = `UPDATE matches SET participant1_disqualified = TRUE WHERE id = $1`
+	} else {
+		query = `UPDATE matches SET participant2_disqualified = TRUE WHERE id = $1`
+	}
+
+	res, err := r.db.ExecContext(ctx, query, matchID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrMatchNotFound
+	}
+
+	return nil
+}
+
+// SetWithdrawn flags the participant in the given slot (1 or 2) as withdrawn.
+func (r *matchRepository) SetWithdrawn(ctx context.Context, matchID uint64, slot int) error {
+	var query string
+	if slot == 1 {
+		query = `UPDATE matches SET participant1_withdrawn = TRUE WHERE id = $1`
+	} else {
+		query = `UPDATE matches SET participant2_withdrawn = TRUE WHERE id = $1`
+	}
+
+	res, err := r.db.ExecContext(ctx, query, matchID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrMatchNotFound
+	}
+
+	return nil
+}
+
+// ReplaceByTournament deletes the tournament's matches, with their sets and slots, and
+// saves the given matches in their place, in one transaction. The matches must be
+// fully built: their IDs and links only need to be consistent with each other, and are
+// rewritten to the IDs assigned on insert. Heat slots are saved from Match.Slots.
+// byes are the IDs of the completed matches the caller checked to be byes: the old
+// matches are locked first, and ErrMatchStatusChanged is returned if any other match
+// has been completed since.
+func (r *matchRepository) ReplaceByTournament(ctx context.Context, tournamentID uint64, byes []uint64, matches []*domain.Match) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx,
+		"SELECT id, status FROM matches WHERE tournament_id = $1 FOR UPDATE", tournamentID)
+	if err != nil {
+		return err
+	}
+	completed := make(map[uint64]bool)
+	for rows.Next() {
+		var id uint64
+		var status domain.MatchStatus
+		if err := rows.Scan(&id, &status); err != nil {
+			rows.Close()
+			return err
+		}
+		if status == domain.MatchCompleted {
+			completed[id] = true
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if !sameIDs(completed, byes) {
+		return ErrMatchStatusChanged
+	}
+
+	// Sets and slots are deleted with their matches (ON DELETE CASCADE)
+	if _, err := tx.ExecContext(ctx, "DELETE FROM matches WHERE tournament_id = $1", tournamentID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO matches (tournament_id, stage, pool, bracket_type, round, position, participant1_id, participant2_id, participant1_name, participant2_name, seed1, seed2, winner_id, status, scheduled_at, advance_count, forfeit_winner_id, best_of, sets_to_win, allow_tied_sets, scoring_mode)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+		RETURNING id
+	`
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	newIDs := make(map[uint64]uint64, len(matches))
+	for _, m := range matches {
+		var id uint64
+		err := stmt.QueryRowContext(ctx,
+			tournamentID, m.Stage, m.Pool, m.BracketType, m.Round, m.Position,
+			m.Participant1ID, m.Participant2ID, m.Participant1Name, m.Participant2Name,
+			m.Seed1, m.Seed2, m.WinnerID, m.Status, m.ScheduledAt, m.AdvanceCount, m.ForfeitWinnerID,
+			m.Format.BestOf, m.Format.SetsToWin, m.Format.AllowTiedSets, scoringMode(m.Format.Scoring),
+		).Scan(&id)
+		if err != nil {
+			return err
+		}
+		newIDs[m.ID] = id
+	}
+
+	remap := func(id *uint64) *uint64 {
+		if id == nil {
+			return nil
+		}
+		newID := newIDs[*id]
+		return &newID
+	}
+	for _, m := range matches {
+		m.ID = newIDs[m.ID]
+		m.TournamentID = tournamentID
+		m.NextMatchID = remap(m.NextMatchID)
+		m.LoserMatchID = remap(m.LoserMatchID)
+		if m.NextMatchID == nil && m.LoserMatchID == nil {
+			continue
+		}
+		_, err := tx.ExecContext(ctx,
+			"UPDATE matches SET next_match_id = $1, loser_match_id = $2 WHERE id = $3",
+			m.NextMatchID, m.LoserMatchID, m.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, m := range matches {
+		for i := range m.Slots {
+			s := &m.Slots[i]
+			s.MatchID = m.ID
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO match_slots (match_id, slot_number, participant_id, participant_name, seed, placement)
+				VALUES ($1, $2, $3, $4, $5, $6)
+			`, s.MatchID, s.SlotNumber, s.ParticipantID, s.ParticipantName, s.Seed, s.Placement)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// scoringMode returns the value stored for a match's scoring mode; matches generated
+// without one are set based.
+func scoringMode(mode domain.ScoringMode) domain.ScoringMode {
+	if mode == "" {
+		return domain.ScoringSets
+	}
+	return mode
+}
+
+// sameIDs reports whether ids holds exactly the IDs in set.
+func sameIDs(set map[uint64]bool, ids []uint64) bool {
+	if len(set) != len(ids) {
+		return false
+	}
+	for _, id := range ids {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,356 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// memoryMatchRepository keeps matches in memory. It backs bracket previews, which run
+// the same generation code as the real thing without writing to the database.
+// It is not safe for concurrent use.
+type memoryMatchRepository struct {
+	matches map[uint64]*domain.Match
+	nextID  uint64
+}
+
+// NewMemoryMatchRepository returns an empty in-memory match repository. IDs are
+// assigned from 1.
+func NewMemoryMatchRepository() MatchRepository {
+	return &memoryMatchRepository{
+		matches: make(map[uint64]*domain.Match),
+		nextID:  1,
+	}
+}
+
+func (r *memoryMatchRepository) CreateBatch(ctx context.Context, matches []*domain.Match) error {
+	for _, m := range matches {
+		m.ID = r.nextID
+		r.nextID++
+		stored := *m
+		r.matches[m.ID] = &stored
+	}
+	return nil
+}
+
+func (r *memoryMatchRepository) GetByID(ctx context.Context, id uint64) (*domain.Match, error) {
+	m, ok := r.matches[id]
+	if !ok {
+		return nil, ErrMatchNotFound
+	}
+	match := *m
+	return &match, nil
+}
+
+func (r *memoryMatchRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Match, error) {
+	var matches []*domain.Match
+	for _, m := range r.matches {
+		if m.TournamentID == tournamentID {
+			match := *m
+			matches = append(matches, &match)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches, nil
+}
+
+func (r *memoryMatchRepository) GetPendingByParticipant(ctx context.Context, tournamentID, participantID uint64) ([]*domain.Match, error) {
+	matches, _ := r.GetByTournament(ctx, tournamentID)
+	var pending []*domain.Match
+	for _, m := range matches {
+		if m.Status == domain.MatchCompleted {
+			continue
+		}
+		if (m.Participant1ID != nil && *m.Participant1ID == participantID) ||
+			(m.Participant2ID != nil && *m.Participant2ID == participantID) {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+func (r *memoryMatchRepository) UpdateResult(ctx context.Context, matchID uint64, winnerID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	m.WinnerID = &winnerID
+	m.ForfeitWinnerID = nil
+	m.Draw = false
+	m.Status = domain.MatchCompleted
+	return nil
+}
+
+func (r *memoryMatchRepository) UpdateStatus(ctx context.Context, matchID uint64, status domain.MatchStatus) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	m.Status = status
+	return nil
+}
+
+func (r *memoryMatchRepository) UpdateForfeit(ctx context.Context, matchID uint64, winnerID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	m.WinnerID = &winnerID
+	m.ForfeitWinnerID = &winnerID
+	m.Draw = false
+	m.Status = domain.MatchCompleted
+	return nil
+}
+
+func (r *memoryMatchRepository) CompleteWithoutWinner(ctx context.Context, matchID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	m.WinnerID = nil
+	m.ForfeitWinnerID = nil
+	m.Draw = false
+	m.Status = domain.MatchCompleted
+	return nil
+}
+
+func (r *memoryMatchRepository) UpdateDraw(ctx context.Context, matchID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	m.WinnerID = nil
+	m.ForfeitWinnerID = nil
+	m.Draw = true
+	m.Status = domain.MatchCompleted
+	return nil
+}
+
+func (r *memoryMatchRepository) SetParticipant(ctx context.Context, matchID uint64, slot int, participantID uint64, name string, seed int) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	if slot == 1 {
+		m.Participant1ID, m.Participant1Name, m.Seed1 = &participantID, &name, &seed
+		m.Participant1CheckedIn = false
+	} else {
+		m.Participant2ID, m.Participant2Name, m.Seed2 = &participantID, &name, &seed
+		m.Participant2CheckedIn = false
+	}
+	return nil
+}
+
+func (r *memoryMatchRepository) UpdateNextMatchLinks(ctx context.Context, matches []*domain.Match) error {
+	for _, m := range matches {
+		if stored, ok := r.matches[m.ID]; ok {
+			stored.NextMatchID = m.NextMatchID
+			stored.LoserMatchID = m.LoserMatchID
+		}
+	}
+	return nil
+}
+
+func (r *memoryMatchRepository) UpdateSchedule(ctx context.Context, schedule map[uint64]time.Time) error {
+	for matchID, scheduledAt := range schedule {
+		if m, ok := r.matches[matchID]; ok {
+			m.ScheduledAt = &scheduledAt
+		}
+	}
+	return nil
+}
+
+func (r *memoryMatchRepository) CallMatch(ctx context.Context, matchID uint64, deadline time.Time) error {
+	m, ok := r.matches[matchID]
+	if !ok || m.Status != domain.MatchReady {
+		return ErrMatchStatusChanged
+	}
+	m.Status = domain.MatchCalled
+	m.CheckInDeadline = &deadline
+	m.Participant1CheckedIn = false
+	m.Participant2CheckedIn = false
+	return nil
+}
+
+func (r *memoryMatchRepository) CheckIn(ctx context.Context, matchID uint64, slot int) (bool, bool, error) {
+	m, ok := r.matches[matchID]
+	if !ok || m.Status != domain.MatchCalled {
+		return false, false, ErrMatchStatusChanged
+	}
+	if slot == 1 {
+		m.Participant1CheckedIn = true
+	} else {
+		m.Participant2CheckedIn = true
+	}
+	return m.Participant1CheckedIn, m.Participant2CheckedIn, nil
+}
+
+func (r *memoryMatchRepository) GetExpiredCalls(ctx context.Context, now time.Time) ([]*domain.Match, error) {
+	var matches []*domain.Match
+	for _, m := range r.matches {
+		if m.Status == domain.MatchCalled && m.CheckInDeadline != nil && !m.CheckInDeadline.After(now) {
+			matches = append(matches, m)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches, nil
+}
+
+func (r *memoryMatchRepository) ReopenMatch(ctx context.Context, matchID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	m.WinnerID = nil
+	m.ForfeitWinnerID = nil
+	m.Draw = false
+	m.Status = domain.MatchReady
+	m.CheckInDeadline = nil
+	m.Participant1CheckedIn = false
+	m.Participant2CheckedIn = false
+	return nil
+}
+
+func (r *memoryMatchRepository) ClearParticipant(ctx context.Context, matchID uint64, slot int) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	if slot == 1 {
+		m.Participant1ID, m.Participant1Name, m.Participant1Disqualified, m.Participant1Withdrawn = nil, nil, false, false
+	} else {
+		m.Participant2ID, m.Participant2Name, m.Participant2Disqualified, m.Participant2Withdrawn = nil, nil, false, false
+	}
+	return nil
+}
+
+func (r *memoryMatchRepository) Delete(ctx context.Context, matchID uint64) error {
+	if _, ok := r.matches[matchID]; !ok {
+		return ErrMatchNotFound
+	}
+	delete(r.matches, matchID)
+	return nil
+}
+
+func (r *memoryMatchRepository) SetDisqualified(ctx context.Context, matchID uint64, slot int) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	if slot == 1 {
+		m.Participant1Disqualified = true
+	} else {
+		m.Participant2Disqualified = true
+	}
+	return nil
+}
+
+func (r *memoryMatchRepository) SetWithdrawn(ctx context.Context, matchID uint64, slot int) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return ErrMatchNotFound
+	}
+	if slot == 1 {
+		m.Participant1Withdrawn = true
+	} else {
+		m.Participant2Withdrawn = true
+	}
+	return nil
+}
+
+func (r *memoryMatchRepository) ReplaceByTournament(ctx context.Context, tournamentID uint64, byes []uint64, matches []*domain.Match) error {
+	completed := make(map[uint64]bool)
+	for _, m := range r.matches {
+		if m.TournamentID == tournamentID && m.Status == domain.MatchCompleted {
+			completed[m.ID] = true
+		}
+	}
+	if !sameIDs(completed, byes) {
+		return ErrMatchStatusChanged
+	}
+
+	for id, m := range r.matches {
+		if m.TournamentID == tournamentID {
+			delete(r.matches, id)
+		}
+	}
+
+	newIDs := make(map[uint64]uint64, len(matches))
+	for _, m := range matches {
+		newIDs[m.ID] = r.nextID
+		r.nextID++
+	}
+	remap := func(id *uint64) *uint64 {
+		if id == nil {
+			return nil
+		}
+		newID := newIDs[*id]
+		return &newID
+	}
+	for _, m := range matches {
+		m.ID = newIDs[m.ID]
+		m.TournamentID = tournamentID
+		m.NextMatchID = remap(m.NextMatchID)
+		m.LoserMatchID = remap(m.LoserMatchID)
+		stored := *m
+		r.matches[m.ID] = &stored
+	}
+	return nil
+}
+
+// memorySlotRepository keeps heat slots in memory for bracket previews.
+type memorySlotRepository struct {
+	slots map[uint64][]domain.Slot
+}
+
+// NewMemorySlotRepository returns an empty in-memory slot repository.
+func NewMemorySlotRepository() SlotRepository {
+	return &memorySlotRepository{slots: make(map[uint64][]domain.Slot)}
+}
+
+func (r *memorySlotRepository) GetByMatchID(ctx context.Context, matchID uint64) ([]domain.Slot, error) {
+	return append([]domain.Slot(nil), r.slots[matchID]...), nil
+}
+
+func (r *memorySlotRepository) GetByMatchIDs(ctx context.Context, matchIDs []uint64) (map[uint64][]domain.Slot, error) {
+	result := make(map[uint64][]domain.Slot)
+	for _, id := range matchIDs {
+		if slots, ok := r.slots[id]; ok {
+			result[id] = append([]domain.Slot(nil), slots...)
+		}
+	}
+	return result, nil
+}
+
+func (r *memorySlotRepository) CreateBatch(ctx context.Context, slots []domain.Slot) error {
+	for _, s := range slots {
+		r.slots[s.MatchID] = append(r.slots[s.MatchID], s)
+	}
+	return nil
+}
+
+func (r *memorySlotRepository) SetParticipant(ctx context.Context, matchID uint64, slotNumber int, participantID uint64, name string, seed int) error {
+	for i := range r.slots[matchID] {
+		s := &r.slots[matchID][i]
+		if s.SlotNumber == slotNumber {
+			s.ParticipantID, s.ParticipantName, s.Seed = &participantID, &name, &seed
+			return nil
+		}
+	}
+	return ErrSlotNotFound
+}
+
+func (r *memorySlotRepository) UpdatePlacements(ctx context.Context, matchID uint64, placements []domain.Placement) error {
+	for _, p := range placements {
+		for i := range r.slots[matchID] {
+			s := &r.slots[matchID][i]
+			if s.ParticipantID != nil && *s.ParticipantID == p.ParticipantID {
+				placement := p.Placement
+				s.Placement = &placement
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+type ReportRepository interface {
+	Save(ctx context.Context, report *domain.MatchReport) ([]domain.MatchReport, error)
+	GetByMatchID(ctx context.Context, matchID uint64) ([]domain.MatchReport, error)
+	GetExpired(ctx context.Context, now time.Time) ([]domain.MatchReport, error)
+	DeleteByMatchID(ctx context.Context, matchID uint64) error
+}
+
+type reportRepository struct {
+	db *sql.DB
+}
+
+func NewReportRepository(db *sql.DB) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+// Save stores a participant's report, replacing any report they made for the match
+// before, and returns every report on the match including it. The match row is locked
+// while the report is saved and the reports are read back, so of two participants
+// reporting at once, the second sees the first's report.
+func (r *reportRepository) Save(ctx context.Context, report *domain.MatchReport) ([]domain.MatchReport, error) {
+	sets, err := json.Marshal(report.Result.Sets)
+	if err != nil {
+		return nil, err
+	}
+
+	var outcome *string
+	if report.Result.Outcome != "" {
+		o := string(report.Result.Outcome)
+		outcome = &o
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var matchID uint64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM matches WHERE id = $1 FOR UPDATE`, report.MatchID).Scan(&matchID)
+	if err == sql.ErrNoRows {
+		return nil, ErrMatchNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO match_reports (match_id, participant_id, user_id, sets, outcome, confirm_deadline)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (match_id, participant_id) DO UPDATE
+		SET user_id = EXCLUDED.user_id, sets = EXCLUDED.sets, outcome = EXCLUDED.outcome,
+			confirm_deadline = EXCLUDED.confirm_deadline
+		RETURNING id, created_at, updated_at
+	`
+	err = tx.QueryRowContext(ctx, query,
+		report.MatchID, report.ParticipantID, report.UserID, sets, outcome, report.ConfirmDeadline,
+	).Scan(&report.ID, &report.CreatedAt, &report.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := queryReports(ctx, tx, matchReportsQuery, report.MatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, tx.Commit()
+}
+
+// GetByMatchID returns the reports made for a match, oldest first.
+func (r *reportRepository) GetByMatchID(ctx context.Context, matchID uint64) ([]domain.MatchReport, error) {
+	return queryReports(ctx, r.db, matchReportsQuery, matchID)
+}
+
+const matchReportsQuery = `
+	SELECT id, match_id, participant_id, user_id, sets, outcome, confirm_deadline, created_at, updated_at
+	FROM match_reports
+	WHERE match_id = $1
+	ORDER BY created_at, id
+`
+
+// GetExpired returns every report whose confirm deadline passed by now, across all
+// tournaments.
+func (r *reportRepository) GetExpired(ctx context.Context, now time.Time) ([]domain.MatchReport, error) {
+	query := `
+		SELECT id, match_id, participant_id, user_id, sets, outcome, confirm_deadline, created_at, updated_at
+		FROM match_reports
+		WHERE confirm_deadline <= $1
+		ORDER BY match_id, id
+	`
+	return queryReports(ctx, r.db, query, now)
+}
+
+func (r *reportRepository) DeleteByMatchID(ctx context.Context, matchID uint64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM match_reports WHERE match_id = $1`, matchID)
+	return err
+}
+
+// queryer is implemented by both *sql.DB and *sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func queryReports(ctx context.Context, db queryer, query string, args ...interface{}) ([]domain.MatchReport, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []domain.MatchReport
+	for rows.Next() {
+		var rep domain.MatchReport
+		var sets []byte
+		var outcome sql.NullString
+		err := rows.Scan(
+			&rep.ID, &rep.MatchID, &rep.ParticipantID, &rep.UserID, &sets, &outcome,
+			&rep.ConfirmDeadline, &rep.CreatedAt, &rep.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(sets, &rep.Result.Sets); err != nil {
+			return nil, err
+		}
+		rep.Result.Outcome = domain.MatchOutcome(outcome.String)
+		reports = append(reports, rep)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
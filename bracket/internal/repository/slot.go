@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/lib/pq"
+)
+
+var ErrSlotNotFound = errors.New("slot not found")
+
+type SlotRepository interface {
+	GetByMatchID(ctx context.Context, matchID uint64) ([]domain.Slot, error)
+	GetByMatchIDs(ctx context.Context, matchIDs []uint64) (map[uint64][]domain.Slot, error)
+	CreateBatch(ctx context.Context, slots []domain.Slot) error
+	SetParticipant(ctx context.Context, matchID uint64, slotNumber int, participantID uint64, name string, seed int) error
+	UpdatePlacements(ctx context.Context, matchID uint64, placements []domain.Placement) error
+}
+
+type slotRepository struct {
+	db *sql.DB
+}
+
+func NewSlotRepository(db *sql.DB) SlotRepository {
+	return &slotRepository{db: db}
+}
+
+func (r *slotRepository) GetByMatchID(ctx context.Context, matchID uint64) ([]domain.Slot, error) {
+	slots, err := r.GetByMatchIDs(ctx, []uint64{matchID})
+	if err != nil {
+		return nil, err
+	}
+	return slots[matchID], nil
+}
+
+func (r *slotRepository) GetByMatchIDs(ctx context.Context, matchIDs []uint64) (map[uint64][]domain.Slot, error) {
+	if len(matchIDs) == 0 {
+		return make(map[uint64][]domain.Slot), nil
+	}
+
+	query := `
+		SELECT id, match_id, slot_number, participant_id, participant_name, seed, placement, created_at, updated_at
+		FROM match_slots
+		WHERE match_id = ANY($1)
+		ORDER BY match_id, slot_number
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(matchIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[uint64][]domain.Slot)
+	for rows.Next() {
+		var s domain.Slot
+		err := rows.Scan(
+			&s.ID, &s.MatchID, &s.SlotNumber,
+			&s.ParticipantID, &s.ParticipantName, &s.Seed, &s.Placement,
+			&s.CreatedAt, &s.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result[s.MatchID] = append(result[s.MatchID], s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *slotRepository) CreateBatch(ctx context.Context, slots []domain.Slot) error {
+	if len(slots) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO match_slots (match_id, slot_number, participant_id, participant_name, seed, placement)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, s := range slots {
+		_, err := stmt.ExecContext(ctx, s.MatchID, s.SlotNumber, s.ParticipantID, s.ParticipantName, s.Seed, s.Placement)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *slotRepository) SetParticipant(ctx context.Context, matchID uint64, slotNumber int, participantID uint64, name string, seed int) error {
+	query := `
+		UPDATE match_slots SET participant_id = $1, participant_name = $2, seed = $3
+		WHERE match_id = $4 AND slot_number = $5
+	`
+	res, err := r.db.ExecContext(ctx, query, participantID, name, seed, matchID, slotNumber)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrSlotNotFound
+	}
+
+	return nil
+}
+
+// UpdatePlacements records each participant's finishing position in a heat.
+func (r *slotRepository) UpdatePlacements(ctx context.Context, matchID uint64, placements []domain.Placement) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE match_slots SET placement = $1 WHERE match_id = $2 AND participant_id = $3`
+	for _, p := range placements {
+		res, err := tx.ExecContext(ctx, query, p.Placement, matchID, p.ParticipantID)
+		if err != nil {
+			return err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return ErrSlotNotFound
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+var ErrStationNotFound = errors.New("station not found")
+
+type StationRepository interface {
+	Create(ctx context.Context, station *domain.Station) error
+	GetByID(ctx context.Context, id uint64) (*domain.Station, error)
+	GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Station, error)
+	Update(ctx context.Context, station *domain.Station) error
+	Delete(ctx context.Context, id uint64) error
+	Assign(ctx context.Context, stationID, matchID uint64) error
+	Release(ctx context.Context, matchID uint64) error
+}
+
+type stationRepository struct {
+	db *sql.DB
+}
+
+func NewStationRepository(db *sql.DB) StationRepository {
+	return &stationRepository{db: db}
+}
+
+func (r *stationRepository) Create(ctx context.Context, station *domain.Station) error {
+	query := `
+		INSERT INTO stations (tournament_id, name, stream, active)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`
+	return r.db.QueryRowContext(ctx, query, station.TournamentID, station.Name, station.Stream, station.Active).
+		Scan(&station.ID, &station.CreatedAt, &station.UpdatedAt)
+}
+
+func (r *stationRepository) GetByID(ctx context.Context, id uint64) (*domain.Station, error) {
+	query := `
+		SELECT id, tournament_id, name, stream, active, match_id, created_at, updated_at
+		FROM stations
+		WHERE id = $1
+	`
+	var s domain.Station
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&s.ID, &s.TournamentID, &s.Name, &s.Stream, &s.Active, &s.MatchID, &s.CreatedAt, &s.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrStationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// GetByTournament returns a tournament's stations in the order they were added.
+func (r *stationRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Station, error) {
+	query := `
+		SELECT id, tournament_id, name, stream, active, match_id, created_at, updated_at
+		FROM stations
+		WHERE tournament_id = $1
+		ORDER BY id
+	`
+	rows, err := r.db.QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stations []*domain.Station
+	for rows.Next() {
+		var s domain.Station
+		err := rows.Scan(
+			&s.ID, &s.TournamentID, &s.Name, &s.Stream, &s.Active, &s.MatchID, &s.CreatedAt, &s.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		stations = append(stations, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stations, nil
+}
+
+// Update saves a station's name, stream flag and active flag.
+func (r *stationRepository) Update(ctx context.Context, station *domain.Station) error {
+	query := `UPDATE stations SET name = $1, stream = $2, active = $3 WHERE id = $4`
+	res, err := r.db.ExecContext(ctx, query, station.Name, station.Stream, station.Active, station.ID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrStationNotFound
+	}
+
+	return nil
+}
+
+func (r *stationRepository) Delete(ctx context.Context, id uint64) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM stations WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrStationNotFound
+	}
+
+	return nil
+}
+
+// Assign puts a match on a station.
+func (r *stationRepository) Assign(ctx context.Context, stationID, matchID uint64) error {
+	res, err := r.db.ExecContext(ctx, `UPDATE stations SET match_id = $1 WHERE id = $2`, matchID, stationID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrStationNotFound
+	}
+
+	return nil
+}
+
+// Release frees the station a match is on. A match that is not on a station is ignored.
+func (r *stationRepository) Release(ctx context.Context, matchID uint64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE stations SET match_id = NULL WHERE match_id = $1`, matchID)
+	return err
+}
@@ -2,172 +2,298 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/braccet/bracket/internal/client"
 	"github.com/braccet/bracket/internal/domain"
 	"github.com/braccet/bracket/internal/engine"
 	"github.com/braccet/bracket/internal/repository"
 )
 
+var (
+	ErrNotSwiss        = errors.New("bracket is not a swiss event")
+	ErrSwissComplete   = errors.New("all swiss rounds have been played")
+	ErrRoundInProgress = engine.ErrRoundInProgress
+)
+
 type BracketService interface {
 	GenerateSingleElimination(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error)
+	GenerateDoubleElimination(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error)
+	GenerateRoundRobin(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error)
+	GenerateSwiss(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error)
+	GenerateMultiStage(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error)
+	NextSwissRound(ctx context.Context, tournamentID uint64) (*BracketState, error)
+	PreviewBracket(ctx context.Context, tournamentID uint64, format string, participants []domain.Participant) (*BracketState, error)
+	RegenerateBracket(ctx context.Context, tournamentID uint64, format string, participants []domain.Participant) (*BracketState, error)
 }
 
 type bracketService struct {
-	repo repository.MatchRepository
+	repo             repository.MatchRepository
+	slotRepo         repository.SlotRepository
+	tournamentClient client.TournamentClient
 }
 
-func NewBracketService(repo repository.MatchRepository) BracketService {
-	return &bracketService{repo: repo}
+func NewBracketService(repo repository.MatchRepository, slotRepo repository.SlotRepository, tournamentClient client.TournamentClient) BracketService {
+	return &bracketService{
+		repo:             repo,
+		slotRepo:         slotRepo,
+		tournamentClient: tournamentClient,
+	}
 }
 
-// GenerateSingleElimination creates a single elimination bracket and persists it.
+// GenerateSingleElimination creates a single elimination bracket and persists it,
+// sized with byes or play-ins and with a third-place match and consolation bracket as
+// the tournament's settings ask. Participants sharing a tag are kept apart early on
+// if the tournament asks for it.
 func (s *bracketService) GenerateSingleElimination(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error) {
+	settings, err := loadSettings(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	participants, conflicts := separateTags(participants, settings)
+
 	// Generate matches in memory
-	matches, err := engine.SingleElimination(tournamentID, participants)
+	matches, link, err := generateFormat(tournamentID, engine.FormatSingleElim, participants, settings)
 	if err != nil {
 		return nil, err
 	}
 
-	// Save matches to DB (assigns IDs)
-	if err := s.repo.CreateBatch(ctx, matches); err != nil {
+	state, err := s.persist(ctx, tournamentID, matches, link, settings)
+	if err != nil {
+		return nil, err
+	}
+	state.SeedingConflicts = conflicts
+	return state, nil
+}
+
+// GenerateDoubleElimination creates a double elimination bracket (winners bracket,
+// losers bracket and grand final) and persists it. Participants sharing a tag are
+// kept apart early in the winners bracket if the tournament asks for it.
+func (s *bracketService) GenerateDoubleElimination(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error) {
+	settings, err := loadSettings(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
 		return nil, err
 	}
+	participants, conflicts := separateTags(participants, settings)
 
-	// Link matches now that we have IDs
-	engine.LinkMatches(matches)
+	// Generate matches in memory
+	matches, err := engine.DoubleElimination(tournamentID, participants)
+	if err != nil {
+		return nil, err
+	}
 
-	// Persist the links
-	if err := s.repo.UpdateNextMatchLinks(ctx, matches); err != nil {
+	state, err := s.persist(ctx, tournamentID, matches, engine.LinkDoubleElimination, settings)
+	if err != nil {
 		return nil, err
 	}
+	state.SeedingConflicts = conflicts
+	return state, nil
+}
 
-	// Advance bye winners through the bracket
-	if err := s.advanceByeWinners(ctx, matches); err != nil {
+// GenerateRoundRobin creates a round robin where everyone plays everyone once and persists it.
+func (s *bracketService) GenerateRoundRobin(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error) {
+	settings, err := loadSettings(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
 		return nil, err
 	}
 
+	// Generate matches in memory
+	matches, err := engine.RoundRobin(tournamentID, participants)
+	if err != nil {
+		return nil, err
+	}
+
+	// Round robin matches are not linked to each other
+	return s.persist(ctx, tournamentID, matches, nil, settings)
+}
+
+// GenerateSwiss pairs the first round of a Swiss event and persists it. Later rounds
+// are paired one at a time with NextSwissRound.
+func (s *bracketService) GenerateSwiss(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error) {
+	settings, err := loadSettings(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate matches in memory
+	matches, err := engine.SwissRound(tournamentID, participants, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swiss matches are not linked to each other
+	return s.persist(ctx, tournamentID, matches, nil, settings)
+}
+
+// GenerateMultiStage generates the first stage of a multi-stage tournament, using the
+// stage list configured on the tournament. Later stages are generated automatically
+// from the pool standings once the stage before them is complete.
+func (s *bracketService) GenerateMultiStage(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error) {
+	tournament, err := loadStagedTournament(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate matches in memory
+	stages := tournament.Stages
+	matches, link, err := generateStage(tournamentID, stages[0], len(stages) == 1, participants, tournament.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.persist(ctx, tournamentID, matches, link, tournament.Settings)
+}
+
+// NextSwissRound pairs the next round of a Swiss event from the results so far.
+// Every match of the current round must be completed first. In a multi-stage
+// tournament only the current stage's matches count.
+func (s *bracketService) NextSwissRound(ctx context.Context, tournamentID uint64) (*BracketState, error) {
+	all, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	stage := engine.CurrentStage(all)
+	history := engine.StageMatches(all, stage)
+	if !engine.IsSwiss(history) {
+		return nil, ErrNotSwiss
+	}
+
+	settings, err := loadSettings(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants := engine.SwissParticipants(history)
+	played := 0
+	for _, m := range history {
+		played = max(played, m.Round)
+	}
+	if played >= swissRounds(settings, len(participants)) {
+		return nil, ErrSwissComplete
+	}
+
+	// Withdrawn, disqualified and substituted participants keep their results but are not paired
+	active, err := activeParticipants(ctx, s.tournamentClient, participants)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := engine.SwissRound(tournamentID, active, history)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		m.Stage = stage
+	}
+	applyMatchFormats(matches, settings)
+
+	if err := s.repo.CreateBatch(ctx, matches); err != nil {
+		return nil, err
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, tournamentID)
+
 	// Reload matches to get final state
 	matches, err = s.repo.GetByTournament(ctx, tournamentID)
 	if err != nil {
 		return nil, err
 	}
 
-	return buildBracketState(tournamentID, matches), nil
+	return buildBracketState(tournamentID, matches, settings), nil
 }
 
-// advanceByeWinners propagates winners from bye matches to subsequent rounds.
-func (s *bracketService) advanceByeWinners(ctx context.Context, matches []*domain.Match) error {
-	// Process completed matches (byes) and advance their winners
-	for _, match := range matches {
-		if match.Status == domain.MatchCompleted && match.WinnerID != nil && match.NextMatchID != nil {
-			// Find the next match
-			var nextMatch *domain.Match
-			for _, m := range matches {
-				if m.ID == *match.NextMatchID {
-					nextMatch = m
-					break
-				}
-			}
-
-			if nextMatch == nil {
-				continue
-			}
-
-			// Determine winner's name and seed
-			winnerName := ""
-			winnerSeed := 0
-			if match.Participant1ID != nil && *match.Participant1ID == *match.WinnerID {
-				if match.Participant1Name != nil {
-					winnerName = *match.Participant1Name
-				}
-				if match.Seed1 != nil {
-					winnerSeed = *match.Seed1
-				}
-			} else {
-				if match.Participant2Name != nil {
-					winnerName = *match.Participant2Name
-				}
-				if match.Seed2 != nil {
-					winnerSeed = *match.Seed2
-				}
-			}
-
-			// Determine slot based on position
-			slot := 1
-			if match.Position%2 == 0 {
-				slot = 2
-			}
-
-			if err := s.repo.SetParticipant(ctx, nextMatch.ID, slot, *match.WinnerID, winnerName, winnerSeed); err != nil {
-				return err
-			}
-
-			// Update in-memory for subsequent iterations
-			if slot == 1 {
-				nextMatch.Participant1ID = match.WinnerID
-				nextMatch.Participant1Name = &winnerName
-				nextMatch.Seed1 = &winnerSeed
-			} else {
-				nextMatch.Participant2ID = match.WinnerID
-				nextMatch.Participant2Name = &winnerName
-				nextMatch.Seed2 = &winnerSeed
-			}
+// activeParticipants returns the participants who are still in the tournament, i.e.
+// have not withdrawn, been disqualified or been substituted. Without a tournament
+// client all of them are.
+func activeParticipants(ctx context.Context, tournamentClient client.TournamentClient, participants []domain.Participant) ([]domain.Participant, error) {
+	if tournamentClient == nil {
+		return participants, nil
+	}
+
+	var active []domain.Participant
+	for _, p := range participants {
+		participant, err := tournamentClient.GetParticipant(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load participant %d: %w", p.ID, err)
+		}
+		if !isActive(participant.Status) {
+			continue
 		}
+		active = append(active, p)
 	}
+	return active, nil
+}
 
-	// Check if any next-round matches are now ready (both participants set)
-	for _, match := range matches {
-		if match.Status == domain.MatchPending && match.Participant1ID != nil && match.Participant2ID != nil {
-			if err := s.repo.UpdateStatus(ctx, match.ID, domain.MatchReady); err != nil {
-				return err
-			}
-			match.Status = domain.MatchReady
+// isActive reports whether a participant with the given status is still in the
+// tournament.
+func isActive(status string) bool {
+	switch status {
+	case client.ParticipantWithdrawn, client.ParticipantDisqualified, client.ParticipantSubstituted:
+		return false
+	}
+	return true
+}
+
+// persist saves generated matches, schedules them if the tournament is scheduled, and
+// returns the resulting bracket state.
+func (s *bracketService) persist(ctx context.Context, tournamentID uint64, matches []*domain.Match, link func([]*domain.Match), settings client.TournamentSettings) (*BracketState, error) {
+	if err := saveMatches(ctx, s.repo, tournamentID, matches, link, settings); err != nil {
+		return nil, err
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, tournamentID)
+
+	// Reload matches to get final state
+	matches, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBracketState(tournamentID, matches, settings), nil
+}
+
+// saveMatches saves generated matches in the tournament's match format, links them with
+// the given linker once IDs are assigned, and advances bye winners through the bracket.
+// A nil linker leaves the matches unlinked. Matches without a stage belong to the first one.
+func saveMatches(ctx context.Context, repo repository.MatchRepository, tournamentID uint64, matches []*domain.Match, link func([]*domain.Match), settings client.TournamentSettings) error {
+	for _, m := range matches {
+		if m.Stage == 0 {
+			m.Stage = 1
 		}
 	}
+	applyMatchFormats(matches, settings)
 
-	// Recursively handle any matches that became completed due to both participants being byes
-	// (This handles cases like only 2 participants in an 8-bracket where multiple rounds auto-complete)
-	for _, match := range matches {
-		if match.Status == domain.MatchReady {
-			// Check if this is a bye match (one participant nil)
-			if match.Participant1ID == nil || match.Participant2ID == nil {
-				// Auto-complete the bye
-				var winnerID *uint64
-				if match.Participant1ID != nil {
-					winnerID = match.Participant1ID
-				} else {
-					winnerID = match.Participant2ID
-				}
-
-				if winnerID != nil {
-					// For bye matches, just update the winner directly (no sets needed)
-					if err := s.repo.UpdateResult(ctx, match.ID, *winnerID); err != nil {
-						return err
-					}
-					match.Status = domain.MatchCompleted
-					match.WinnerID = winnerID
-
-					// Recurse to handle this completed match
-					if match.NextMatchID != nil {
-						return s.advanceByeWinners(ctx, matches)
-					}
-				}
-			}
+	// Save matches to DB (assigns IDs)
+	if err := repo.CreateBatch(ctx, matches); err != nil {
+		return err
+	}
+
+	if link != nil {
+		// Link matches now that we have IDs
+		link(matches)
+
+		// Persist the links
+		if err := repo.UpdateNextMatchLinks(ctx, matches); err != nil {
+			return err
 		}
 	}
 
-	return nil
+	// Advance bye winners through the bracket
+	return settleByes(ctx, repo, tournamentID)
 }
 
-func buildBracketState(tournamentID uint64, matches []*domain.Match) *BracketState {
-	if len(matches) == 0 {
+// buildBracketState summarizes a bracket. Settings are only used by formats whose
+// length is configurable (Swiss). Rounds and completion describe the current stage;
+// Matches holds every stage.
+func buildBracketState(tournamentID uint64, all []*domain.Match, settings client.TournamentSettings) *BracketState {
+	if len(all) == 0 {
 		return &BracketState{TournamentID: tournamentID}
 	}
 
 	state := &BracketState{
 		TournamentID: tournamentID,
-		Matches:      matches,
+		Stage:        engine.CurrentStage(all),
+		Matches:      all,
 	}
+	matches := engine.StageMatches(all, state.Stage)
 
 	for _, m := range matches {
 		if m.Round > state.TotalRounds {
@@ -182,11 +308,19 @@ func buildBracketState(tournamentID uint64, matches []*domain.Match) *BracketSta
 		}
 	}
 
-	for _, m := range matches {
-		if m.Round == state.TotalRounds && m.WinnerID != nil {
-			state.IsComplete = true
-			state.ChampionID = m.WinnerID
-			break
+	// Round robin and Swiss champions come from the standings, which the caller resolves.
+	// Pool stages only produce qualifiers for the next stage.
+	switch {
+	case engine.IsPoolStage(matches):
+	case engine.IsRoundRobin(matches):
+		state.IsComplete = engine.AllCompleted(matches)
+	case engine.IsSwiss(matches):
+		state.TotalRounds = max(state.TotalRounds, swissRounds(settings, len(engine.SwissParticipants(matches))))
+		state.IsComplete = engine.AllCompleted(matches) && state.CurrentRound >= state.TotalRounds
+	default:
+		if championID := engine.Champion(matches); championID != nil {
+			state.ChampionID = championID
+			state.IsComplete = engine.SideMatchesDecided(matches)
 		}
 	}
 
@@ -0,0 +1,813 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+)
+
+func makeParticipants(n int) []domain.Participant {
+	participants := make([]domain.Participant, n)
+	for i := range n {
+		participants[i] = domain.Participant{
+			ID:   uint64(i + 1),
+			Name: string(rune('A' + i)),
+			Seed: i + 1,
+		}
+	}
+	return participants
+}
+
+// findMatch returns the stored match with the given bracket type, round and position.
+func findMatch(t *testing.T, repo *mockMatchRepository, bracketType domain.BracketType, round, position int) *domain.Match {
+	t.Helper()
+	for _, m := range repo.matches {
+		if m.BracketType == bracketType && m.Round == round && m.Position == position {
+			copy := *m
+			return &copy
+		}
+	}
+	t.Fatalf("no %s match at round %d position %d", bracketType, round, position)
+	return nil
+}
+
+func TestGenerateDoubleElimination_LosersDropIn(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateDoubleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Winners round 1: 1v4, 2v3. Top seeds win.
+	w1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	w2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if err := matchSvc.ReportResult(ctx, w1.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := matchSvc.ReportResult(ctx, w2.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	l1 := findMatch(t, repo, domain.BracketLosers, 1, 1)
+	if l1.Participant1ID == nil || *l1.Participant1ID != 4 || l1.Participant2ID == nil || *l1.Participant2ID != 3 {
+		t.Fatal("expected seeds 4 and 3 to drop into losers round 1")
+	}
+	if l1.Status != domain.MatchReady {
+		t.Errorf("expected losers round 1 to be ready, got %s", l1.Status)
+	}
+
+	// Winners final: 1 beats 2, 2 drops to losers final slot 2
+	wf := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	matchSvc.ReportResult(ctx, wf.ID, p1Wins)
+	// Losers round 1: 3 beats 4
+	matchSvc.ReportResult(ctx, l1.ID, p2Wins)
+
+	lf := findMatch(t, repo, domain.BracketLosers, 2, 1)
+	if lf.Participant1ID == nil || *lf.Participant1ID != 3 || lf.Participant2ID == nil || *lf.Participant2ID != 2 {
+		t.Fatal("expected losers final to be 3 vs 2")
+	}
+
+	// Losers final: 2 wins and meets 1 in the grand final
+	matchSvc.ReportResult(ctx, lf.ID, p2Wins)
+	gf := findMatch(t, repo, domain.BracketGrandFinal, 1, 1)
+	if gf.Participant1ID == nil || *gf.Participant1ID != 1 || gf.Participant2ID == nil || *gf.Participant2ID != 2 {
+		t.Fatal("expected grand final to be 1 vs 2")
+	}
+
+	state, _ := matchSvc.GetBracketState(ctx, 1)
+	if state.IsComplete {
+		t.Error("bracket should not be complete before the grand final")
+	}
+
+	matchSvc.ReportResult(ctx, gf.ID, p1Wins)
+	state, _ = matchSvc.GetBracketState(ctx, 1)
+	if !state.IsComplete || state.ChampionID == nil || *state.ChampionID != 1 {
+		t.Error("expected participant 1 to be champion")
+	}
+}
+
+func TestGenerateDoubleElimination_ByesInLosersBracket(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	// 3 participants: seed 1 has a bye, so losers round 1 only ever gets one player
+	if _, err := bracketSvc.GenerateDoubleElimination(ctx, 1, makeParticipants(3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wf := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if wf.Participant1ID == nil || *wf.Participant1ID != 1 {
+		t.Fatal("expected seed 1 to advance through the bye")
+	}
+
+	// 2 beats 3; 3 drops into losers round 1 and advances through the empty slot
+	w2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if err := matchSvc.ReportResult(ctx, w2.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	l1 := findMatch(t, repo, domain.BracketLosers, 1, 1)
+	if l1.Status != domain.MatchCompleted || l1.WinnerID == nil || *l1.WinnerID != 3 {
+		t.Error("expected losers round 1 to be a bye won by seed 3")
+	}
+	lf := findMatch(t, repo, domain.BracketLosers, 2, 1)
+	if lf.Participant1ID == nil || *lf.Participant1ID != 3 {
+		t.Error("expected seed 3 to reach the losers final")
+	}
+}
+
+func TestReopenMatch_ClearsDroppedLoser(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	bracketSvc.GenerateDoubleElimination(ctx, 1, makeParticipants(4))
+
+	w1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	matchSvc.ReportResult(ctx, w1.ID, p1Wins)
+
+	if _, err := matchSvc.ReopenMatch(ctx, w1.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	l1 := findMatch(t, repo, domain.BracketLosers, 1, 1)
+	if l1.Participant1ID != nil {
+		t.Error("expected dropped loser to be cleared from losers round 1")
+	}
+	w2 := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if w2.Participant1ID != nil {
+		t.Error("expected winner to be cleared from winners final")
+	}
+}
+
+// mockTournamentClient implements client.TournamentClient for testing
+type mockTournamentClient struct {
+	settings    client.TournamentSettings
+	stages      []client.StageResponse
+	startsAt    *time.Time
+	organizerID uint64
+	userIDs     map[uint64]uint64 // User ID by participant ID
+	statuses    map[uint64]string // Status by participant ID
+	err         error             // Returned by GetTournament, if set
+}
+
+func (c *mockTournamentClient) GetTournament(ctx context.Context, id uint64) (*client.TournamentResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &client.TournamentResponse{
+		ID:          id,
+		OrganizerID: c.organizerID,
+		Settings:    c.settings,
+		Stages:      c.stages,
+		StartsAt:    c.startsAt,
+	}, nil
+}
+
+func (c *mockTournamentClient) GetParticipant(ctx context.Context, id uint64) (*client.ParticipantResponse, error) {
+	// Every test tournament is tournament 1, named as in makeParticipants
+	participant := &client.ParticipantResponse{
+		ID:           id,
+		TournamentID: 1,
+		DisplayName:  string(rune('A' + id - 1)),
+		Status:       c.statuses[id],
+	}
+	if userID, ok := c.userIDs[id]; ok {
+		participant.UserID = &userID
+	}
+	return participant, nil
+}
+
+// playToGrandFinal plays a 4 player double elimination bracket so that seed 1 wins
+// the winners bracket and seed 2 wins the losers bracket.
+func playToGrandFinal(t *testing.T, repo *mockMatchRepository, matchSvc MatchService) *domain.Match {
+	t.Helper()
+	ctx := context.Background()
+	for _, pos := range []int{1, 2} {
+		matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketWinners, 1, pos).ID, p1Wins)
+	}
+	matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketWinners, 2, 1).ID, p1Wins)
+	matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketLosers, 1, 1).ID, p2Wins)
+	matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketLosers, 2, 1).ID, p2Wins)
+	return findMatch(t, repo, domain.BracketGrandFinal, 1, 1)
+}
+
+func TestGrandFinalReset(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{GrandFinalReset: true}}
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	bracketSvc.GenerateDoubleElimination(ctx, 1, makeParticipants(4))
+	gf := playToGrandFinal(t, repo, matchSvc)
+
+	// Losers bracket finalist wins: bracket resets
+	if err := matchSvc.ReportResult(ctx, gf.ID, p2Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reset := findMatch(t, repo, domain.BracketGrandFinal, 2, 1)
+	if reset.Status != domain.MatchReady || *reset.Participant1ID != 1 || *reset.Participant2ID != 2 {
+		t.Fatal("expected a ready reset match between 1 and 2")
+	}
+
+	state, _ := matchSvc.GetBracketState(ctx, 1)
+	if state.IsComplete {
+		t.Error("bracket should not be complete before the reset is played")
+	}
+
+	matchSvc.ReportResult(ctx, reset.ID, p1Wins)
+	state, _ = matchSvc.GetBracketState(ctx, 1)
+	if !state.IsComplete || state.ChampionID == nil || *state.ChampionID != 1 {
+		t.Error("expected reset winner to be champion")
+	}
+
+	// Reopening the first grand final removes the reset
+	if _, err := matchSvc.ReopenMatch(ctx, gf.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, m := range repo.matches {
+		if m.BracketType == domain.BracketGrandFinal && m.Round == 2 {
+			t.Fatal("expected reset match to be deleted")
+		}
+	}
+}
+
+func TestGrandFinalReset_EditAfterPlayedReset(t *testing.T) {
+	repo := newMockRepo()
+	eventRepo := newMockEventRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{GrandFinalReset: true}}
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), eventRepo, tournaments, nil)
+	ctx := context.Background()
+
+	bracketSvc.GenerateDoubleElimination(ctx, 1, makeParticipants(4))
+	gf := playToGrandFinal(t, repo, matchSvc)
+
+	// The settings cannot be read: the grand final is left untouched
+	tournaments.err = errors.New("tournament service is down")
+	if err := matchSvc.ReportResult(ctx, gf.ID, p2Wins); !errors.Is(err, tournaments.err) {
+		t.Fatalf("expected the settings error, got %v", err)
+	}
+	if gf = findMatch(t, repo, domain.BracketGrandFinal, 1, 1); gf.Status == domain.MatchCompleted {
+		t.Fatal("expected no result to be saved without the reset setting")
+	}
+	tournaments.err = nil
+
+	if err := matchSvc.ReportResult(ctx, gf.ID, p2Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reset := findMatch(t, repo, domain.BracketGrandFinal, 2, 1)
+	if err := matchSvc.ReportResult(ctx, reset.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The grand final was scored the wrong way round: the played reset goes, with its
+	// result kept in the audit log
+	resp, err := matchSvc.EditResult(ctx, gf.ID, p1Wins)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, reset.ID); err == nil {
+		t.Fatal("expected the reset match to be deleted")
+	}
+	if len(resp.CascadeMatches) != 1 || resp.CascadeMatches[0].ID != reset.ID {
+		t.Errorf("expected the reset to be reported as reopened, got %d matches", len(resp.CascadeMatches))
+	}
+	events, _ := eventRepo.GetByMatchID(ctx, reset.ID)
+	if len(events) != 2 || events[1].Type != domain.EventCascade || !events[1].Before.Completed {
+		t.Errorf("expected the reset's result to be cleared by a cascade, got %+v", events)
+	}
+
+	state, _ := matchSvc.GetBracketState(ctx, 1)
+	if !state.IsComplete || state.ChampionID == nil || *state.ChampionID != 1 {
+		t.Error("expected the winners bracket finalist to be champion")
+	}
+}
+
+func TestGrandFinalReset_Disabled(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), &mockTournamentClient{}, nil)
+	ctx := context.Background()
+
+	bracketSvc.GenerateDoubleElimination(ctx, 1, makeParticipants(4))
+	gf := playToGrandFinal(t, repo, matchSvc)
+	matchSvc.ReportResult(ctx, gf.ID, p2Wins)
+
+	state, _ := matchSvc.GetBracketState(ctx, 1)
+	if !state.IsComplete || state.ChampionID == nil || *state.ChampionID != 2 {
+		t.Error("expected grand final winner to be champion without a reset")
+	}
+}
+
+func TestThirdPlaceMatch(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{ThirdPlaceMatch: true}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Seeds 1 and 2 win their semifinals
+	matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketWinners, 1, 1).ID, p1Wins)
+	matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketWinners, 1, 2).ID, p1Wins)
+
+	thirdPlace := findMatch(t, repo, domain.BracketThirdPlace, 2, 1)
+	if thirdPlace.Status != domain.MatchReady || *thirdPlace.Participant1ID != 4 || *thirdPlace.Participant2ID != 3 {
+		t.Fatal("expected the semifinal losers to meet in the third-place match")
+	}
+
+	matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketWinners, 2, 1).ID, p1Wins)
+	state, _ := matchSvc.GetBracketState(ctx, 1)
+	if state.ChampionID == nil || *state.ChampionID != 1 {
+		t.Fatal("expected the final winner to be champion")
+	}
+	if state.IsComplete {
+		t.Error("expected the bracket to wait for the third-place match")
+	}
+
+	matchSvc.ReportResult(ctx, thirdPlace.ID, p2Wins)
+	state, _ = matchSvc.GetBracketState(ctx, 1)
+	if !state.IsComplete || *state.ChampionID != 1 {
+		t.Error("expected the bracket to be complete with the final winner as champion")
+	}
+}
+
+func TestConsolationBracket_EditFixesSlot(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{ConsolationBracket: true}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 1 vs 8 and 4 vs 5 feed the first consolation match
+	first := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	matchSvc.ReportResult(ctx, first.ID, p1Wins)
+	matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketWinners, 1, 2).ID, p1Wins)
+
+	consolation := findMatch(t, repo, domain.BracketConsolation, 1, 1)
+	if consolation.Status != domain.MatchReady || *consolation.Participant1ID != 8 || *consolation.Participant2ID != 5 {
+		t.Fatal("expected the first round losers to meet in the consolation bracket")
+	}
+	matchSvc.ReportResult(ctx, consolation.ID, p1Wins)
+
+	// Flipping the first round result reopens the consolation match with the new loser
+	if _, err := matchSvc.EditResult(ctx, first.ID, p2Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	consolation = findMatch(t, repo, domain.BracketConsolation, 1, 1)
+	if consolation.Status != domain.MatchReady || consolation.WinnerID != nil || *consolation.Participant1ID != 1 {
+		t.Error("expected the consolation match to be reopened with participant 1 in slot 1")
+	}
+}
+
+func TestPlayInRound(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{BracketSizing: engine.SizingPlayIn}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	// 6 participants: seeds 1 and 2 go straight in, 3 vs 6 and 4 vs 5 play in
+	state, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(6))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(state.Matches) != 5 || state.TotalRounds != 3 {
+		t.Fatalf("expected 5 matches over 3 rounds, got %d over %d", len(state.Matches), state.TotalRounds)
+	}
+
+	var playIn *domain.Match
+	for _, m := range state.Matches {
+		if m.Round == 1 && *m.Seed1 == 3 {
+			playIn = m
+		}
+	}
+	if playIn == nil || *playIn.Seed2 != 6 {
+		t.Fatal("expected seed 3 to play in against seed 6")
+	}
+
+	matchSvc.ReportResult(ctx, playIn.ID, p2Wins)
+
+	// The play-in winner takes seed 3's spot against seed 2
+	semifinal := findMatch(t, repo, domain.BracketWinners, 2, 2)
+	if semifinal.Status != domain.MatchReady || *semifinal.Participant1ID != 2 || *semifinal.Participant2ID != 6 {
+		t.Error("expected seed 2 to meet the play-in winner")
+	}
+}
+
+func TestRoundRobin_StandingsAndChampion(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{Tiebreakers: []string{"set_differential"}}}
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	state, err := bracketSvc.GenerateRoundRobin(ctx, 1, makeParticipants(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(state.Matches) != 6 || state.TotalRounds != 3 {
+		t.Fatalf("expected 6 matches over 3 rounds, got %d over %d", len(state.Matches), state.TotalRounds)
+	}
+
+	// Lower seed wins every match
+	for _, m := range state.Matches {
+		result := p1Wins
+		if *m.Participant2ID < *m.Participant1ID {
+			result = p2Wins
+		}
+		if err := matchSvc.ReportResult(ctx, m.ID, result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	standings, err := matchSvc.GetStandings(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(standings.Tiebreakers) != 1 || standings.Tiebreakers[0] != engine.TiebreakerSetDifferential {
+		t.Errorf("expected configured tiebreakers, got %v", standings.Tiebreakers)
+	}
+	for i, row := range standings.Entries {
+		if row.ParticipantID != uint64(i+1) || row.Wins != 3-i {
+			t.Errorf("rank %d: expected participant %d with %d wins, got %d with %d", i+1, i+1, 3-i, row.ParticipantID, row.Wins)
+		}
+	}
+
+	final, _ := matchSvc.GetBracketState(ctx, 1)
+	if !final.IsComplete || final.ChampionID == nil || *final.ChampionID != 1 {
+		t.Error("expected standings leader to be champion")
+	}
+}
+
+func TestGetStandings_NotRoundRobin(t *testing.T) {
+	repo := newMockRepo()
+	NewBracketService(repo, newMockSlotRepo(), nil).GenerateSingleElimination(context.Background(), 1, makeParticipants(4))
+
+	_, err := newTestMatchService(repo).GetStandings(context.Background(), 1)
+	if !errors.Is(err, ErrNoStandings) {
+		t.Errorf("expected ErrNoStandings, got %v", err)
+	}
+}
+
+func TestSwiss_NextRoundAndChampion(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{SwissRounds: 2}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	state, err := bracketSvc.GenerateSwiss(ctx, 1, makeParticipants(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state.TotalRounds != 2 || len(state.Matches) != 2 {
+		t.Fatalf("expected 2 first round matches of 2 rounds, got %d of %d", len(state.Matches), state.TotalRounds)
+	}
+
+	if _, err := bracketSvc.NextSwissRound(ctx, 1); !errors.Is(err, ErrRoundInProgress) {
+		t.Errorf("expected ErrRoundInProgress, got %v", err)
+	}
+
+	playRound := func(matches []*domain.Match) {
+		for _, m := range matches {
+			if m.Status == domain.MatchCompleted {
+				continue
+			}
+			result := p1Wins
+			if *m.Participant2ID < *m.Participant1ID {
+				result = p2Wins
+			}
+			if err := matchSvc.ReportResult(ctx, m.ID, result); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+
+	playRound(state.Matches)
+	state, err = bracketSvc.NextSwissRound(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(state.Matches) != 4 || state.CurrentRound != 2 {
+		t.Fatalf("expected round 2 to be paired, got %d matches in round %d", len(state.Matches), state.CurrentRound)
+	}
+
+	playRound(state.Matches)
+	if _, err := bracketSvc.NextSwissRound(ctx, 1); !errors.Is(err, ErrSwissComplete) {
+		t.Errorf("expected ErrSwissComplete, got %v", err)
+	}
+
+	final, _ := matchSvc.GetBracketState(ctx, 1)
+	if !final.IsComplete || final.ChampionID == nil || *final.ChampionID != 1 {
+		t.Error("expected undefeated participant 1 to be champion")
+	}
+
+	standings, _ := matchSvc.GetStandings(ctx, 1)
+	if standings.Tiebreakers[0] != engine.TiebreakerBuchholz {
+		t.Errorf("expected swiss default tiebreakers, got %v", standings.Tiebreakers)
+	}
+}
+
+func TestGenerateSwiss_ConfiguredRounds(t *testing.T) {
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{SwissRounds: 3}}
+	bracketSvc := NewBracketService(newMockRepo(), newMockSlotRepo(), tournaments)
+
+	// 4 players would play 2 rounds by default
+	state, err := bracketSvc.GenerateSwiss(context.Background(), 1, makeParticipants(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state.TotalRounds != 3 {
+		t.Errorf("expected the configured 3 rounds, got %d", state.TotalRounds)
+	}
+}
+
+func TestNextSwissRound_SkipsInactiveParticipants(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{statuses: map[uint64]string{}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	state, err := bracketSvc.GenerateSwiss(ctx, 1, makeParticipants(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, m := range state.Matches {
+		if err := matchSvc.ReportResult(ctx, m.ID, p1Wins); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Participant 4 withdraws between rounds: the other three are paired, one with a bye
+	tournaments.statuses[4] = client.ParticipantWithdrawn
+	state, err = bracketSvc.NextSwissRound(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var round2 []*domain.Match
+	for _, m := range state.Matches {
+		if m.Round == 2 {
+			round2 = append(round2, m)
+		}
+	}
+	if len(round2) != 2 {
+		t.Fatalf("expected a match and a bye in round 2, got %d matches", len(round2))
+	}
+	for _, m := range round2 {
+		if isParticipant(m, 4) {
+			t.Error("expected the withdrawn participant not to be paired")
+		}
+	}
+}
+
+func TestNextSwissRound_NotSwiss(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	bracketSvc.GenerateRoundRobin(context.Background(), 1, makeParticipants(4))
+
+	if _, err := bracketSvc.NextSwissRound(context.Background(), 1); !errors.Is(err, ErrNotSwiss) {
+		t.Errorf("expected ErrNotSwiss, got %v", err)
+	}
+}
+
+func TestMultiStage_PoolsIntoPlayoff(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{stages: []client.StageResponse{
+		{StageNumber: 1, Format: "round_robin", PoolCount: 2, QualifiersPerPool: 2},
+		{StageNumber: 2, Format: "single_elimination", PoolCount: 1},
+	}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	// Snake draft: pool 1 gets seeds 1, 4, 5, 8 and pool 2 gets seeds 2, 3, 6, 7
+	state, err := bracketSvc.GenerateMultiStage(ctx, 1, makeParticipants(8))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(state.Matches) != 12 || state.Stage != 1 || state.IsComplete {
+		t.Fatalf("expected 12 pool matches in stage 1, got %d in stage %d", len(state.Matches), state.Stage)
+	}
+
+	// The better seed wins every pool match
+	for _, m := range state.Matches {
+		if m.Pool == 0 {
+			t.Fatalf("match %d is not assigned to a pool", m.ID)
+		}
+		result := p1Wins
+		if *m.Participant2ID < *m.Participant1ID {
+			result = p2Wins
+		}
+		if err := matchSvc.ReportResult(ctx, m.ID, result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	state, _ = matchSvc.GetBracketState(ctx, 1)
+	if state.Stage != 2 || state.IsComplete {
+		t.Fatalf("expected the playoff stage to start, got stage %d", state.Stage)
+	}
+
+	// Pool winners 1 and 2 are seeded above runners-up 3 and 4
+	semi1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	semi2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if semi1.Stage != 2 || *semi1.Participant1ID != 1 || *semi1.Participant2ID != 4 {
+		t.Errorf("expected 1 vs 4 in the first semifinal, got %v vs %v", *semi1.Participant1ID, *semi1.Participant2ID)
+	}
+	if *semi2.Participant1ID != 2 || *semi2.Participant2ID != 3 {
+		t.Errorf("expected 2 vs 3 in the second semifinal, got %v vs %v", *semi2.Participant1ID, *semi2.Participant2ID)
+	}
+
+	// Pool results seeded the playoff and can no longer change
+	if _, err := matchSvc.ReopenMatch(ctx, state.Matches[0].ID); !errors.Is(err, ErrStageLocked) {
+		t.Errorf("expected ErrStageLocked, got %v", err)
+	}
+
+	matchSvc.ReportResult(ctx, semi1.ID, p1Wins)
+	matchSvc.ReportResult(ctx, semi2.ID, p1Wins)
+	matchSvc.ReportResult(ctx, findMatch(t, repo, domain.BracketWinners, 2, 1).ID, p1Wins)
+
+	final, _ := matchSvc.GetBracketState(ctx, 1)
+	if !final.IsComplete || final.ChampionID == nil || *final.ChampionID != 1 {
+		t.Error("expected participant 1 to win the playoff")
+	}
+}
+
+func TestPreviewBracket_SavesNothing(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	ctx := context.Background()
+
+	state, err := bracketSvc.PreviewBracket(ctx, 1, "single_elimination", makeParticipants(6))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.matches) != 0 {
+		t.Errorf("expected preview to save no matches, got %d", len(repo.matches))
+	}
+	if len(state.Matches) != 7 {
+		t.Fatalf("expected 7 matches, got %d", len(state.Matches))
+	}
+
+	// Seeds 1 and 2 have byes; they are already in round 2 and projected to meet in the final
+	for _, m := range state.Matches {
+		if m.Round == 1 && (m.Position == 1 || m.Position == 3) && m.Status != domain.MatchCompleted {
+			t.Errorf("expected bye R1M%d to be settled", m.Position)
+		}
+		if m.Round == 3 {
+			p := state.Projections[m.ID]
+			if p.Participant1 == nil || p.Participant2 == nil || p.Participant1.Seed+p.Participant2.Seed != 3 {
+				t.Error("expected seeds 1 and 2 projected into the final")
+			}
+		}
+	}
+}
+
+func TestPreviewBracket_UnsupportedFormat(t *testing.T) {
+	bracketSvc := NewBracketService(newMockRepo(), newMockSlotRepo(), nil)
+
+	_, err := bracketSvc.PreviewBracket(context.Background(), 1, "ladder", makeParticipants(4))
+	if !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
+
+func TestRegenerateBracket(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	// 3 participants: seed 1's bye is settled at generation and must not block a reseed
+	participants := makeParticipants(3)
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, participants); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Swap seeds 1 and 3
+	participants[0].Seed, participants[2].Seed = 3, 1
+	if _, err := bracketSvc.RegenerateBracket(ctx, 1, "single_elimination", participants); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.matches) != 3 {
+		t.Fatalf("expected old matches to be replaced, got %d matches", len(repo.matches))
+	}
+	final := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if final.Participant1ID == nil || *final.Participant1ID != 3 {
+		t.Error("expected the new seed 1 to advance through the bye")
+	}
+
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if semi.NextMatchID == nil || *semi.NextMatchID != final.ID {
+		t.Error("expected regenerated matches to be linked")
+	}
+	if err := matchSvc.ReportResult(ctx, semi.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err := bracketSvc.RegenerateBracket(ctx, 1, "single_elimination", participants)
+	if !errors.Is(err, ErrBracketStarted) {
+		t.Errorf("expected ErrBracketStarted once a match was played, got %v", err)
+	}
+}
+
+func TestRegenerateBracket_VerifiesParticipants(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{statuses: map[uint64]string{4: client.ParticipantWithdrawn}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		tournamentID uint64
+		participants []domain.Participant
+	}{
+		{"withdrawn", 1, makeParticipants(4)},
+		{"duplicate", 1, append(makeParticipants(3), domain.Participant{ID: 1, Seed: 4})},
+		{"other tournament", 2, makeParticipants(2)},
+	}
+	for _, tt := range tests {
+		_, err := bracketSvc.RegenerateBracket(ctx, tt.tournamentID, "single_elimination", tt.participants)
+		if !errors.Is(err, ErrInvalidParticipants) {
+			t.Errorf("%s: expected ErrInvalidParticipants, got %v", tt.name, err)
+		}
+	}
+
+	// Names come from the tournament service, not the request
+	participants := makeParticipants(3)
+	participants[0].Name = "Someone Else"
+	state, err := bracketSvc.RegenerateBracket(ctx, 1, "single_elimination", participants)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, m := range state.Matches {
+		if m.Participant1ID != nil && *m.Participant1ID == 1 && *m.Participant1Name != "A" {
+			t.Errorf("expected participant 1's registered name, got %q", *m.Participant1Name)
+		}
+	}
+}
+
+func TestRegenerateBracket_FreeForAllByeHeat(t *testing.T) {
+	repo := newMockRepo()
+	slotRepo := newMockSlotRepo()
+	bracketSvc := NewBracketService(repo, slotRepo, nil)
+	heatSvc := NewHeatService(repo, slotRepo, newMockStationRepo(), newMockEventRepo(), nil)
+	ctx := context.Background()
+
+	// 5 participants in heats of 4: the heat seating seeds 2 and 3 advances both at generation
+	participants := makeParticipants(5)
+	if _, err := heatSvc.GenerateFreeForAll(ctx, 1, participants); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bye := findMatch(t, repo, domain.BracketHeat, 1, 2)
+	if bye.Status != domain.MatchCompleted {
+		t.Fatalf("expected heat 2 to be settled as a bye, got %s", bye.Status)
+	}
+	if _, err := bracketSvc.RegenerateBracket(ctx, 1, "free_for_all", participants); err != nil {
+		t.Fatalf("expected the bye heat not to block regeneration, got %v", err)
+	}
+
+	// Once the other heat is raced, the bracket is locked in
+	repo, slotRepo = newMockRepo(), newMockSlotRepo()
+	bracketSvc = NewBracketService(repo, slotRepo, nil)
+	heatSvc = NewHeatService(repo, slotRepo, newMockStationRepo(), newMockEventRepo(), nil)
+	if _, err := heatSvc.GenerateFreeForAll(ctx, 1, participants); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	heat := findMatch(t, repo, domain.BracketHeat, 1, 1)
+	if err := heatSvc.ReportPlacements(ctx, heat.ID, placements(5, 1, 4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err := bracketSvc.RegenerateBracket(ctx, 1, "free_for_all", participants)
+	if !errors.Is(err, ErrBracketStarted) {
+		t.Errorf("expected ErrBracketStarted once a heat was raced, got %v", err)
+	}
+}
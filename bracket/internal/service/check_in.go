@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+// DefaultCheckInWindow is how long participants of a called match have to check in
+// when the tournament does not set a window.
+const DefaultCheckInWindow = 10 * time.Minute
+
+var (
+	ErrMatchNotCalled   = errors.New("match has not been called")
+	ErrNotInMatch       = errors.New("participant is not playing in this match")
+	ErrNotCheckInPlayer = errors.New("only the tournament organizer and the match's participants can check in")
+)
+
+// CallMatch calls a ready match to be played. Both participants have the tournament's
+// check-in window to confirm they are present; the match starts once they both have.
+func (s *matchService) CallMatch(ctx context.Context, matchID uint64) (*domain.Match, error) {
+	match, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if match.BracketType == domain.BracketHeat {
+		return nil, ErrHeatMatch
+	}
+	if match.Status != domain.MatchReady || match.Participant1ID == nil || match.Participant2ID == nil {
+		return nil, ErrMatchNotReady
+	}
+
+	settings, err := loadSettings(ctx, s.tournamentClient, match.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	window := DefaultCheckInWindow
+	if settings.CheckInMinutes > 0 {
+		window = time.Duration(settings.CheckInMinutes) * time.Minute
+	}
+
+	// The match may have been called or started since it was read
+	if err := s.repo.CallMatch(ctx, matchID, time.Now().Add(window)); err != nil {
+		if errors.Is(err, repository.ErrMatchStatusChanged) {
+			return nil, ErrMatchNotReady
+		}
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, matchID)
+}
+
+// CheckIn confirms that a participant of a called match is present. Participants check
+// themselves in, as the user playing them; the organizer can check in either
+// participant on their behalf by naming them. The match starts once both participants
+// have checked in.
+func (s *matchService) CheckIn(ctx context.Context, matchID, userID uint64, participantID *uint64) (*domain.Match, error) {
+	match, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if match.Status != domain.MatchCalled {
+		return nil, ErrMatchNotCalled
+	}
+
+	tournament, err := s.tournamentClient.GetTournament(ctx, match.TournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tournament: %w", err)
+	}
+	var id uint64
+	if tournament.OrganizerID == userID {
+		if participantID == nil {
+			return nil, ErrNotInMatch
+		}
+		id = *participantID
+	} else {
+		playerID, ok, err := participantOfUser(ctx, s.tournamentClient, match, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || (participantID != nil && *participantID != playerID) {
+			return nil, ErrNotCheckInPlayer
+		}
+		id = playerID
+	}
+
+	var slot int
+	switch {
+	case match.Participant1ID != nil && *match.Participant1ID == id:
+		slot = 1
+	case match.Participant2ID != nil && *match.Participant2ID == id:
+		slot = 2
+	default:
+		return nil, ErrNotInMatch
+	}
+
+	// Decide from the flags as they stand after this check-in, not as they were read
+	// above, in case the opponent checked in meanwhile
+	checkedIn1, checkedIn2, err := s.repo.CheckIn(ctx, matchID, slot)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchStatusChanged) {
+			return nil, ErrMatchNotCalled
+		}
+		return nil, err
+	}
+	if checkedIn1 && checkedIn2 {
+		if err := s.repo.UpdateStatus(ctx, matchID, domain.MatchInProgress); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.repo.GetByID(ctx, matchID)
+}
+
+// participantOfUser returns the participant of the match the user plays as, if any.
+func participantOfUser(ctx context.Context, tournamentClient client.TournamentClient, match *domain.Match, userID uint64) (uint64, bool, error) {
+	for _, id := range []*uint64{match.Participant1ID, match.Participant2ID} {
+		if id == nil {
+			continue
+		}
+		participant, err := tournamentClient.GetParticipant(ctx, *id)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to load participant %d: %w", *id, err)
+		}
+		if participant.UserID != nil && *participant.UserID == userID {
+			return *id, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// ExpireCalls settles every called match whose check-in window closed by now. If only
+// one participant checked in, the other forfeits the match (and, in an elimination
+// bracket, is not dropped into a loser match; see forfeitTo). If nobody checked in, the
+// match goes back to ready for the organizer to call again or double forfeit. A match
+// that cannot be settled is logged and retried next time.
+func (s *matchService) ExpireCalls(ctx context.Context, now time.Time) error {
+	matches, err := s.repo.GetExpiredCalls(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		if err := s.expireCall(ctx, match); err != nil {
+			log.Printf("Failed to expire check-in for match %d: %v", match.ID, err)
+		}
+	}
+	return nil
+}
+
+// expireCall settles one called match whose check-in window has closed.
+func (s *matchService) expireCall(ctx context.Context, match *domain.Match) error {
+	var winnerID uint64
+	switch {
+	case match.Participant1CheckedIn && match.Participant2CheckedIn:
+		return s.repo.UpdateStatus(ctx, match.ID, domain.MatchInProgress)
+	case match.Participant1CheckedIn:
+		winnerID = *match.Participant1ID
+	case match.Participant2CheckedIn:
+		winnerID = *match.Participant2ID
+	default:
+		return s.repo.UpdateStatus(ctx, match.ID, domain.MatchReady)
+	}
+
+	log.Printf("Match %d: no-show forfeited to participant %d", match.ID, winnerID)
+	ctx = withReason(ctx, fmt.Sprintf("participant %d did not check in", *opponentOf(match, winnerID)))
+	before, err := snapshotMatch(ctx, s.repo, s.setRepo, match.ID)
+	if err != nil {
+		return err
+	}
+	if err := forfeitTo(ctx, s.repo, match, winnerID); err != nil {
+		return err
+	}
+	if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, domain.EventForfeit, match, before); err != nil {
+		return err
+	}
+	if err := settleByes(ctx, s.repo, match.TournamentID); err != nil {
+		return err
+	}
+
+	// A no-show can forfeit the last open pool match
+	if err := startNextStage(ctx, s.repo, s.setRepo, s.tournamentClient, match.TournamentID); err != nil {
+		return err
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, match.TournamentID)
+	releaseStation(ctx, s.repo, s.stationRepo, s.tournamentClient, match)
+
+	return nil
+}
+
+// RunCheckInTimer expires closed check-in windows every interval until ctx is done.
+// Deadlines are stored with their matches, so windows that closed while the service
+// was down are settled on the first tick after it comes back.
+func RunCheckInTimer(ctx context.Context, matchSvc MatchService, interval time.Duration) {
+	runEvery(ctx, interval, func(now time.Time) {
+		if err := matchSvc.ExpireCalls(ctx, now); err != nil {
+			log.Printf("Failed to expire check-ins: %v", err)
+		}
+	})
+}
+
+// runEvery calls fn right away and then every interval until ctx is done.
+func runEvery(ctx context.Context, interval time.Duration, fn func(now time.Time)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
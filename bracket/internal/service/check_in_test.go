@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+const checkInOrganizer = 1000
+
+// newTestCheckInService returns a match service for tournaments in which participant N
+// is played by user 100+N.
+func newTestCheckInService(repo *mockMatchRepository) MatchService {
+	tournaments := &mockTournamentClient{
+		organizerID: checkInOrganizer,
+		userIDs:     map[uint64]uint64{1: 101, 2: 102, 3: 103, 4: 104},
+	}
+	return NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+}
+
+func TestCheckIn_BothPresentStartsMatch(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), &mockTournamentClient{})
+	matchSvc := newTestCheckInService(repo)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 1)
+
+	called, err := matchSvc.CallMatch(ctx, semi.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if called.Status != domain.MatchCalled || called.CheckInDeadline == nil {
+		t.Fatalf("expected a called match with a deadline, got %s", called.Status)
+	}
+	if want := time.Now().Add(DefaultCheckInWindow); called.CheckInDeadline.After(want) {
+		t.Errorf("expected check-in to close within %v, got %v", DefaultCheckInWindow, called.CheckInDeadline)
+	}
+
+	// A called match only starts through check-in
+	if err := matchSvc.StartMatch(ctx, semi.ID); err != ErrMatchNotReady {
+		t.Errorf("expected ErrMatchNotReady, got %v", err)
+	}
+
+	// Players cannot check in for someone else, and the organizer must name a player
+	other := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if _, err := matchSvc.CheckIn(ctx, semi.ID, 100+*other.Participant1ID, nil); err != ErrNotCheckInPlayer {
+		t.Errorf("expected ErrNotCheckInPlayer, got %v", err)
+	}
+	if _, err := matchSvc.CheckIn(ctx, semi.ID, 100+*semi.Participant1ID, semi.Participant2ID); err != ErrNotCheckInPlayer {
+		t.Errorf("expected ErrNotCheckInPlayer, got %v", err)
+	}
+	if _, err := matchSvc.CheckIn(ctx, semi.ID, checkInOrganizer, other.Participant1ID); err != ErrNotInMatch {
+		t.Errorf("expected ErrNotInMatch, got %v", err)
+	}
+
+	match, err := matchSvc.CheckIn(ctx, semi.ID, 100+*semi.Participant1ID, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if match.Status != domain.MatchCalled || !match.Participant1CheckedIn {
+		t.Errorf("expected the match to wait for the other participant, got %s", match.Status)
+	}
+
+	// The organizer checks the other participant in on their behalf
+	match, err = matchSvc.CheckIn(ctx, semi.ID, checkInOrganizer, semi.Participant2ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if match.Status != domain.MatchInProgress {
+		t.Errorf("expected the match to start once both checked in, got %s", match.Status)
+	}
+
+	// Another semifinal that was never called cannot be checked into
+	if _, err := matchSvc.CheckIn(ctx, other.ID, 100+*other.Participant1ID, nil); err != ErrMatchNotCalled {
+		t.Errorf("expected ErrMatchNotCalled, got %v", err)
+	}
+}
+
+func TestExpireCalls_NoShowForfeits(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), &mockTournamentClient{})
+	matchSvc := newTestCheckInService(repo)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	semi2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+
+	// Only participant 2 shows up for semifinal 1; nobody shows up for semifinal 2
+	for _, m := range []*domain.Match{semi1, semi2} {
+		if _, err := matchSvc.CallMatch(ctx, m.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := matchSvc.CheckIn(ctx, semi1.ID, 100+*semi1.Participant2ID, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Nothing expires before the window closes
+	if err := matchSvc.ExpireCalls(ctx, time.Now()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if semi1 = findMatch(t, repo, domain.BracketWinners, 1, 1); semi1.Status != domain.MatchCalled {
+		t.Fatalf("expected semifinal 1 to still be called, got %s", semi1.Status)
+	}
+
+	if err := matchSvc.ExpireCalls(ctx, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	semi1 = findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if semi1.Status != domain.MatchCompleted || semi1.ForfeitWinnerID == nil || *semi1.ForfeitWinnerID != *semi1.Participant2ID {
+		t.Errorf("expected semifinal 1 to be forfeited to participant %d", *semi1.Participant2ID)
+	}
+	final := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if final.Participant1ID == nil || *final.Participant1ID != *semi1.Participant2ID {
+		t.Errorf("expected participant %d to advance to the final, got %v", *semi1.Participant2ID, final.Participant1ID)
+	}
+
+	semi2 = findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if semi2.Status != domain.MatchReady {
+		t.Errorf("expected semifinal 2 to go back to ready, got %s", semi2.Status)
+	}
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// Disqualify removes a participant from the bracket for breaking the rules. Every match
+// they have not finished is flagged and forfeited to their opponent; a match that is
+// still waiting for their opponent is forfeited as soon as the opponent arrives. Like a
+// withdrawal, a disqualified participant is never dropped into a loser match.
+//
+// With voidRound, the results they already played in their current round (the latest
+// round they played in each bracket of their current stage) are voided as well: the
+// matches are reopened, along with anything downstream that depended on them, and then
+// forfeited to the opponent. Rating changes from every reopened match are undone.
+func (s *matchService) Disqualify(ctx context.Context, tournamentID, participantID uint64, voidRound bool) (*ForfeitSummary, error) {
+	ctx = withReason(ctx, fmt.Sprintf("participant %d was disqualified", participantID))
+
+	summary := &ForfeitSummary{
+		ForfeitedMatches: make([]uint64, 0),
+		AdvancedWinners:  make([]uint64, 0),
+		VoidedMatches:    make([]uint64, 0),
+	}
+
+	if voidRound {
+		if err := s.voidCurrentRound(ctx, tournamentID, participantID, summary); err != nil {
+			return nil, err
+		}
+	}
+
+	matches, err := s.repo.GetPendingByParticipant(ctx, tournamentID, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, match := range matches {
+		slot := 2
+		if match.Participant1ID != nil && *match.Participant1ID == participantID {
+			slot = 1
+		}
+		if err := s.repo.SetDisqualified(ctx, match.ID, slot); err != nil {
+			return nil, err
+		}
+
+		// Still waiting for an opponent: they win by forfeit when they are placed
+		opponentID := opponentOf(match, participantID)
+		if opponentID == nil {
+			continue
+		}
+
+		before, err := snapshotMatch(ctx, s.repo, s.setRepo, match.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := forfeitTo(ctx, s.repo, match, *opponentID); err != nil {
+			return nil, err
+		}
+		if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, domain.EventForfeit, match, before); err != nil {
+			return nil, err
+		}
+		summary.ForfeitedMatches = append(summary.ForfeitedMatches, match.ID)
+		summary.AdvancedWinners = append(summary.AdvancedWinners, *opponentID)
+	}
+
+	if len(summary.ForfeitedMatches) > 0 {
+		if err := settleByes(ctx, s.repo, tournamentID); err != nil {
+			return nil, err
+		}
+
+		// Forfeiting the last open pool matches starts the next stage
+		if err := startNextStage(ctx, s.repo, s.setRepo, s.tournamentClient, tournamentID); err != nil {
+			return nil, err
+		}
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, tournamentID)
+
+	// The stations of forfeited matches go to the next matches in the queue
+	reassignStations(ctx, s.repo, s.stationRepo, s.tournamentClient, tournamentID)
+
+	// Undo ratings asynchronously (don't fail the disqualification if ELO fails)
+	if len(summary.VoidedMatches) > 0 {
+		go s.voidEloUpdates(context.Background(), tournamentID, summary.VoidedMatches)
+	}
+
+	return summary, nil
+}
+
+// voidCurrentRound reopens the matches a participant played in their current round,
+// recording every match that was reopened on the summary.
+func (s *matchService) voidCurrentRound(ctx context.Context, tournamentID, participantID uint64, summary *ForfeitSummary) error {
+	all, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+
+	for _, played := range currentRoundResults(all, participantID) {
+		// An earlier void may have reopened this match already
+		match, err := s.repo.GetByID(ctx, played.ID)
+		if err != nil {
+			return err
+		}
+		if match.Status != domain.MatchCompleted {
+			continue
+		}
+		if err := ensureStageOpen(ctx, s.repo, match); err != nil {
+			return err
+		}
+
+		var reopened []*domain.Match
+		if err := s.reopenMatchCascade(ctx, match, domain.EventReopen, &reopened); err != nil {
+			return err
+		}
+		for _, m := range reopened {
+			summary.VoidedMatches = append(summary.VoidedMatches, m.ID)
+		}
+	}
+
+	return nil
+}
+
+// currentRoundResults returns the matches a participant played (not byes or forfeits)
+// in the latest round they played in each bracket of their current stage.
+func currentRoundResults(matches []*domain.Match, participantID uint64) []*domain.Match {
+	var played []*domain.Match
+	stage := 0
+	for _, m := range matches {
+		if m.BracketType == domain.BracketHeat || !isParticipant(m, participantID) {
+			continue
+		}
+		if !hasResult(m) || m.ForfeitWinnerID != nil {
+			continue
+		}
+		played = append(played, m)
+		stage = max(stage, m.Stage)
+	}
+
+	latest := make(map[domain.BracketType]int)
+	for _, m := range played {
+		if m.Stage == stage {
+			latest[m.BracketType] = max(latest[m.BracketType], m.Round)
+		}
+	}
+
+	var current []*domain.Match
+	for _, m := range played {
+		if m.Stage == stage && m.Round == latest[m.BracketType] {
+			current = append(current, m)
+		}
+	}
+	return current
+}
+
+// voidEloUpdates undoes the rating changes of voided matches.
+// This runs asynchronously and logs errors rather than failing the disqualification.
+func (s *matchService) voidEloUpdates(ctx context.Context, tournamentID uint64, matchIDs []uint64) {
+	// Skip if clients are not configured
+	if s.tournamentClient == nil || s.communityClient == nil {
+		return
+	}
+
+	tournament, err := s.tournamentClient.GetTournament(ctx, tournamentID)
+	if err != nil {
+		log.Printf("ELO: failed to get tournament %d: %v", tournamentID, err)
+		return
+	}
+
+	// Skip if no ELO system configured
+	if tournament.EloSystemID == nil {
+		return
+	}
+
+	for _, matchID := range matchIDs {
+		if err := s.communityClient.VoidMatchElo(ctx, matchID); err != nil {
+			log.Printf("ELO: failed to void match %d: %v", matchID, err)
+		}
+	}
+}
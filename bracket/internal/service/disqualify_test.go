@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestDisqualify_OpponentWinsOnArrival(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Seed 1 wins round 1, then is disqualified while waiting for their next opponent
+	r1m1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if err := matchSvc.ReportResult(ctx, r1m1.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	summary, err := matchSvc.Disqualify(ctx, 1, 1, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(summary.ForfeitedMatches) != 0 {
+		t.Errorf("expected nothing forfeited yet, got %v", summary.ForfeitedMatches)
+	}
+	if r1m1 = findMatch(t, repo, domain.BracketWinners, 1, 1); r1m1.ForfeitWinnerID != nil {
+		t.Error("expected the played round 1 result to stand")
+	}
+
+	semi := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if !semi.Participant1Disqualified && !semi.Participant2Disqualified {
+		t.Fatal("expected seed 1 to be flagged as disqualified")
+	}
+
+	// The winner of the other quarterfinal wins the semifinal by forfeit
+	r1m2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if err := matchSvc.ReportResult(ctx, r1m2.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi = findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if semi.Status != domain.MatchCompleted || semi.ForfeitWinnerID == nil || *semi.ForfeitWinnerID != *r1m2.Participant1ID {
+		t.Fatal("expected the semifinal to be forfeited to the arriving opponent")
+	}
+	final := findMatch(t, repo, domain.BracketWinners, 3, 1)
+	if !isParticipant(final, *r1m2.Participant1ID) {
+		t.Error("expected the forfeit winner to advance to the final")
+	}
+}
+
+func TestDisqualify_VoidCurrentRound(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if err := matchSvc.ReportResult(ctx, semi.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	winnerID, loserID := *semi.Participant1ID, *semi.Participant2ID
+
+	summary, err := matchSvc.Disqualify(ctx, 1, winnerID, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(summary.VoidedMatches) != 1 || summary.VoidedMatches[0] != semi.ID {
+		t.Errorf("expected the semifinal to be voided, got %v", summary.VoidedMatches)
+	}
+
+	semi = findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if semi.ForfeitWinnerID == nil || *semi.ForfeitWinnerID != loserID {
+		t.Error("expected the voided semifinal to be forfeited to the opponent")
+	}
+	final := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if isParticipant(final, winnerID) || !isParticipant(final, loserID) {
+		t.Error("expected the opponent to take the disqualified participant's place in the final")
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+type actorKey struct{}
+
+// actor is who is changing match results, and why.
+type actor struct {
+	userID *uint64
+	reason string
+}
+
+// WithActor attributes the match result changes made with the returned context to a
+// user, with the reason they gave, in the audit log.
+func WithActor(ctx context.Context, userID uint64, reason string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor{userID: &userID, reason: reason})
+}
+
+// withReason gives the match result changes made with the returned context a reason,
+// unless the acting user already gave one.
+func withReason(ctx context.Context, reason string) context.Context {
+	a, _ := ctx.Value(actorKey{}).(actor)
+	if a.reason != "" {
+		return ctx
+	}
+	a.reason = reason
+	return context.WithValue(ctx, actorKey{}, a)
+}
+
+// snapshotMatch returns a match's result as it stands.
+func snapshotMatch(ctx context.Context, repo repository.MatchRepository, setRepo repository.SetRepository, matchID uint64) (domain.MatchSnapshot, error) {
+	match, err := repo.GetByID(ctx, matchID)
+	if err != nil {
+		return domain.MatchSnapshot{}, err
+	}
+	sets, err := setRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return domain.MatchSnapshot{}, err
+	}
+
+	snapshot := domain.MatchSnapshot{
+		Completed:       match.Status == domain.MatchCompleted,
+		WinnerID:        match.WinnerID,
+		ForfeitWinnerID: match.ForfeitWinnerID,
+		Draw:            match.Draw,
+		Sets:            make([]domain.SetScore, len(sets)),
+	}
+	for i, set := range sets {
+		snapshot.Sets[i] = domain.SetScore{
+			SetNumber:         set.SetNumber,
+			Participant1Score: set.Participant1Score,
+			Participant2Score: set.Participant2Score,
+		}
+	}
+	return snapshot, nil
+}
+
+// recordEvent adds a change to a match's result to the audit log, attributed to the
+// actor in ctx. The log is the record of who changed what, so failing to write it fails
+// the change; the caller returns the error rather than carrying on unrecorded.
+func recordEvent(ctx context.Context, repo repository.MatchRepository, setRepo repository.SetRepository, eventRepo repository.EventRepository, eventType domain.MatchEventType, match *domain.Match, before domain.MatchSnapshot) error {
+	after, err := snapshotMatch(ctx, repo, setRepo, match.ID)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot match %d for the audit log: %w", match.ID, err)
+	}
+	return createEvent(ctx, eventRepo, eventType, match, before, after)
+}
+
+// createEvent adds a change to a match's result, from before to after, to the audit log.
+func createEvent(ctx context.Context, eventRepo repository.EventRepository, eventType domain.MatchEventType, match *domain.Match, before, after domain.MatchSnapshot) error {
+	a, _ := ctx.Value(actorKey{}).(actor)
+	event := &domain.MatchEvent{
+		TournamentID: match.TournamentID,
+		MatchID:      match.ID,
+		Type:         eventType,
+		UserID:       a.userID,
+		Reason:       a.reason,
+		Before:       before,
+		After:        after,
+	}
+	if err := eventRepo.Create(ctx, event); err != nil {
+		return fmt.Errorf("failed to record %s event for match %d: %w", eventType, match.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// mockEventRepository implements repository.EventRepository for testing
+type mockEventRepository struct {
+	events []domain.MatchEvent
+	err    error // Returned by Create instead of recording the event, if set
+}
+
+func newMockEventRepo() *mockEventRepository {
+	return &mockEventRepository{}
+}
+
+func (r *mockEventRepository) Create(ctx context.Context, event *domain.MatchEvent) error {
+	if r.err != nil {
+		return r.err
+	}
+	event.ID = uint64(len(r.events) + 1)
+	r.events = append(r.events, *event)
+	return nil
+}
+
+func (r *mockEventRepository) GetByMatchID(ctx context.Context, matchID uint64) ([]domain.MatchEvent, error) {
+	var events []domain.MatchEvent
+	for _, e := range r.events {
+		if e.MatchID == matchID {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (r *mockEventRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]domain.MatchEvent, error) {
+	var events []domain.MatchEvent
+	for _, e := range r.events {
+		if e.TournamentID == tournamentID {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func TestEvents_EditRecordsChangeAndCascade(t *testing.T) {
+	repo := newMockRepo()
+	eventRepo := newMockEventRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), &mockTournamentClient{})
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), eventRepo, nil, nil)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	semi2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	for _, m := range []*domain.Match{semi1, semi2} {
+		if err := matchSvc.ReportResult(ctx, m.ID, p1Wins); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	final := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if err := matchSvc.ReportResult(ctx, final.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The organizer flips semifinal 1, which sends the wrong player's final back
+	if _, err := matchSvc.EditResult(WithActor(ctx, 42, "scores were swapped"), semi1.ID, p2Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events, _ := eventRepo.GetByMatchID(ctx, semi1.ID)
+	if len(events) != 2 || events[0].Type != domain.EventReport || events[1].Type != domain.EventEdit {
+		t.Fatalf("expected a report and an edit for semifinal 1, got %+v", events)
+	}
+	edit := events[1]
+	if edit.UserID == nil || *edit.UserID != 42 || edit.Reason != "scores were swapped" {
+		t.Errorf("expected the edit to be attributed to user 42 with their reason, got %v %q", edit.UserID, edit.Reason)
+	}
+	if *edit.Before.WinnerID != *semi1.Participant1ID || edit.Before.Sets[0] != p1Wins.Sets[0] {
+		t.Errorf("expected the old result before the edit, got %+v", edit.Before)
+	}
+	if *edit.After.WinnerID != *semi1.Participant2ID || edit.After.Sets[0] != p2Wins.Sets[0] {
+		t.Errorf("expected the new result after the edit, got %+v", edit.After)
+	}
+
+	events, _ = eventRepo.GetByMatchID(ctx, final.ID)
+	if len(events) != 2 || events[1].Type != domain.EventCascade {
+		t.Fatalf("expected the final to be reopened by a cascade, got %+v", events)
+	}
+	cascade := events[1]
+	if !cascade.Before.Completed || cascade.After.Completed || cascade.After.WinnerID != nil || len(cascade.After.Sets) != 0 {
+		t.Errorf("expected the cascade to clear the final's result, got %+v -> %+v", cascade.Before, cascade.After)
+	}
+	if cascade.Reason != "scores were swapped" {
+		t.Errorf("expected the cascade to carry the edit's reason, got %q", cascade.Reason)
+	}
+
+	if events, _ := eventRepo.GetByTournament(ctx, 1); len(events) != 5 {
+		t.Errorf("expected 5 events in the tournament's history, got %d", len(events))
+	}
+}
+
+func TestEvents_FailedWriteFailsTheChange(t *testing.T) {
+	repo := newMockRepo()
+	eventRepo := newMockEventRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), &mockTournamentClient{})
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), eventRepo, nil, nil)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 1)
+
+	eventRepo.err = errors.New("database is down")
+	if err := matchSvc.ReportResult(ctx, semi.ID, p1Wins); !errors.Is(err, eventRepo.err) {
+		t.Errorf("expected the failed audit log write to be returned, got %v", err)
+	}
+}
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/braccet/bracket/internal/client"
 	"github.com/braccet/bracket/internal/domain"
 	"github.com/braccet/bracket/internal/repository"
 )
@@ -15,24 +17,44 @@ var (
 
 type ForfeitService interface {
 	ProcessWithdrawal(ctx context.Context, tournamentID, participantID uint64) (*ForfeitSummary, error)
+	DoubleForfeit(ctx context.Context, matchID uint64) (*domain.Match, error)
 }
 
 type ForfeitSummary struct {
 	ForfeitedMatches []uint64
 	AdvancedWinners  []uint64
+	VoidedMatches    []uint64 // Disqualifications only: played matches whose results were voided
 }
 
 type forfeitService struct {
-	repo repository.MatchRepository
+	repo             repository.MatchRepository
+	setRepo          repository.SetRepository
+	stationRepo      repository.StationRepository
+	eventRepo        repository.EventRepository
+	tournamentClient client.TournamentClient
 }
 
-func NewForfeitService(repo repository.MatchRepository) ForfeitService {
-	return &forfeitService{repo: repo}
+func NewForfeitService(
+	repo repository.MatchRepository,
+	setRepo repository.SetRepository,
+	stationRepo repository.StationRepository,
+	eventRepo repository.EventRepository,
+	tournamentClient client.TournamentClient,
+) ForfeitService {
+	return &forfeitService{
+		repo:             repo,
+		setRepo:          setRepo,
+		stationRepo:      stationRepo,
+		eventRepo:        eventRepo,
+		tournamentClient: tournamentClient,
+	}
 }
 
 // ProcessWithdrawal handles a participant withdrawal by forfeiting their pending matches
 // and advancing opponents through the bracket.
 func (s *forfeitService) ProcessWithdrawal(ctx context.Context, tournamentID, participantID uint64) (*ForfeitSummary, error) {
+	ctx = withReason(ctx, fmt.Sprintf("participant %d withdrew", participantID))
+
 	// Get all pending/ready/in_progress matches for this participant
 	matches, err := s.repo.GetPendingByParticipant(ctx, tournamentID, participantID)
 	if err != nil {
@@ -48,15 +70,30 @@ func (s *forfeitService) ProcessWithdrawal(ctx context.Context, tournamentID, pa
 	for _, match := range matches {
 		opponentID := s.getOpponentID(match, participantID)
 
-		// Skip if no opponent (edge case - shouldn't happen in valid bracket)
+		// Still waiting for an opponent: flag the slot so whoever is placed here wins
+		// by forfeit (or the match is a double forfeit if they withdraw too)
 		if opponentID == nil {
+			slot := 2
+			if match.Participant1ID != nil && *match.Participant1ID == participantID {
+				slot = 1
+			}
+			if err := s.repo.SetWithdrawn(ctx, match.ID, slot); err != nil {
+				return nil, err
+			}
 			continue
 		}
 
 		// Record the forfeit
+		before, err := snapshotMatch(ctx, s.repo, s.setRepo, match.ID)
+		if err != nil {
+			return nil, err
+		}
 		if err := s.repo.UpdateForfeit(ctx, match.ID, *opponentID); err != nil {
 			return nil, err
 		}
+		if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, domain.EventForfeit, match, before); err != nil {
+			return nil, err
+		}
 
 		summary.ForfeitedMatches = append(summary.ForfeitedMatches, match.ID)
 		summary.AdvancedWinners = append(summary.AdvancedWinners, *opponentID)
@@ -69,83 +106,86 @@ func (s *forfeitService) ProcessWithdrawal(ctx context.Context, tournamentID, pa
 		}
 	}
 
-	return summary, nil
-}
+	// The withdrawn participant is not dropped into the losers bracket or the
+	// third-place match, so any slot they would have taken becomes a bye for whoever
+	// is waiting there
+	if len(summary.ForfeitedMatches) > 0 {
+		if err := settleByes(ctx, s.repo, tournamentID); err != nil {
+			return nil, err
+		}
 
-// getOpponentID returns the ID of the opponent in a match, or nil if no opponent.
-func (s *forfeitService) getOpponentID(match *domain.Match, withdrawnID uint64) *uint64 {
-	if match.Participant1ID != nil && *match.Participant1ID == withdrawnID {
-		return match.Participant2ID
-	}
-	if match.Participant2ID != nil && *match.Participant2ID == withdrawnID {
-		return match.Participant1ID
+		// Forfeiting the last open pool matches starts the next stage
+		if err := startNextStage(ctx, s.repo, s.setRepo, s.tournamentClient, tournamentID); err != nil {
+			return nil, err
+		}
 	}
-	return nil
+	reschedule(ctx, s.repo, s.tournamentClient, tournamentID)
+
+	// The stations of forfeited matches go to the next matches in the queue
+	reassignStations(ctx, s.repo, s.stationRepo, s.tournamentClient, tournamentID)
+
+	return summary, nil
 }
 
-// advanceForfeitWinner places the forfeit winner into their next match.
-func (s *forfeitService) advanceForfeitWinner(ctx context.Context, completedMatch *domain.Match, winnerID uint64) error {
-	nextMatch, err := s.repo.GetByID(ctx, *completedMatch.NextMatchID)
+// DoubleForfeit completes a match in which neither participant showed up, with no
+// winner. Nobody advances from it, so the slot it feeds becomes a bye for whoever is
+// waiting there. In double elimination neither participant drops to the losers
+// bracket either; the losers slot is settled the same way. The match's station goes to
+// the next match in the queue.
+func (s *forfeitService) DoubleForfeit(ctx context.Context, matchID uint64) (*domain.Match, error) {
+	match, err := s.repo.GetByID(ctx, matchID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Determine winner's name and seed
-	winnerName := s.getParticipantName(completedMatch, winnerID)
-	winnerSeed := s.getParticipantSeed(completedMatch, winnerID)
-
-	// Determine which slot in the next match (based on position in current round)
-	// Odd positions go to slot 1, even positions go to slot 2
-	slot := 1
-	if completedMatch.Position%2 == 0 {
-		slot = 2
+	if match.BracketType == domain.BracketHeat {
+		return nil, ErrHeatMatch
 	}
-
-	if err := s.repo.SetParticipant(ctx, nextMatch.ID, slot, winnerID, winnerName, winnerSeed); err != nil {
-		return err
+	if match.Status == domain.MatchCompleted {
+		return nil, ErrMatchAlreadyComplete
+	}
+	if match.Participant1ID == nil || match.Participant2ID == nil {
+		return nil, ErrMatchNotReady
 	}
 
-	// Refresh next match to check if both participants are now set
-	nextMatch, err = s.repo.GetByID(ctx, nextMatch.ID)
+	ctx = withReason(ctx, "neither participant showed up")
+	before, err := snapshotMatch(ctx, s.repo, s.setRepo, match.ID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := s.repo.CompleteWithoutWinner(ctx, match.ID); err != nil {
+		return nil, err
+	}
+	if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, domain.EventForfeit, match, before); err != nil {
+		return nil, err
 	}
 
-	// If both participants are set, mark as ready
-	if nextMatch.Participant1ID != nil && nextMatch.Participant2ID != nil {
-		if err := s.repo.UpdateStatus(ctx, nextMatch.ID, domain.MatchReady); err != nil {
-			return err
-		}
+	if err := settleByes(ctx, s.repo, match.TournamentID); err != nil {
+		return nil, err
 	}
 
-	return nil
+	// A double forfeit can be the last open pool match
+	if err := startNextStage(ctx, s.repo, s.setRepo, s.tournamentClient, match.TournamentID); err != nil {
+		return nil, err
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, match.TournamentID)
+	releaseStation(ctx, s.repo, s.stationRepo, s.tournamentClient, match)
+
+	return s.repo.GetByID(ctx, match.ID)
 }
 
-// getParticipantName returns the name of a participant in a match.
-func (s *forfeitService) getParticipantName(match *domain.Match, participantID uint64) string {
-	if match.Participant1ID != nil && *match.Participant1ID == participantID {
-		if match.Participant1Name != nil {
-			return *match.Participant1Name
-		}
+// getOpponentID returns the ID of the opponent in a match, or nil if no opponent.
+func (s *forfeitService) getOpponentID(match *domain.Match, withdrawnID uint64) *uint64 {
+	if match.Participant1ID != nil && *match.Participant1ID == withdrawnID {
+		return match.Participant2ID
 	}
-	if match.Participant2ID != nil && *match.Participant2ID == participantID {
-		if match.Participant2Name != nil {
-			return *match.Participant2Name
-		}
+	if match.Participant2ID != nil && *match.Participant2ID == withdrawnID {
+		return match.Participant1ID
 	}
-	return ""
+	return nil
 }
 
-func (s *forfeitService) getParticipantSeed(match *domain.Match, participantID uint64) int {
-	if match.Participant1ID != nil && *match.Participant1ID == participantID {
-		if match.Seed1 != nil {
-			return *match.Seed1
-		}
-	}
-	if match.Participant2ID != nil && *match.Participant2ID == participantID {
-		if match.Seed2 != nil {
-			return *match.Seed2
-		}
-	}
-	return 0
+// advanceForfeitWinner places the forfeit winner into their next match.
+func (s *forfeitService) advanceForfeitWinner(ctx context.Context, completedMatch *domain.Match, winnerID uint64) error {
+	return placeParticipant(ctx, s.repo, completedMatch, winnerID, *completedMatch.NextMatchID, false)
 }
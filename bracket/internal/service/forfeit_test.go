@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestDoubleForfeit_NextMatchIsBye(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	forfeitSvc := NewForfeitService(repo, newMockSetRepo(), newMockStationRepo(), newMockEventRepo(), nil)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r1m1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	match, err := forfeitSvc.DoubleForfeit(ctx, r1m1.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if match.Status != domain.MatchCompleted || match.WinnerID != nil {
+		t.Fatal("expected the match to complete with no winner")
+	}
+	if _, err := forfeitSvc.DoubleForfeit(ctx, r1m1.ID); err != ErrMatchAlreadyComplete {
+		t.Errorf("expected ErrMatchAlreadyComplete, got %v", err)
+	}
+
+	// The other quarterfinal winner gets a bye through the semifinal
+	r1m2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if err := matchSvc.ReportResult(ctx, r1m2.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	winnerID := *r1m2.Participant1ID
+
+	semi := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if semi.Status != domain.MatchCompleted || semi.WinnerID == nil || *semi.WinnerID != winnerID {
+		t.Fatal("expected the semifinal to be a bye for the other quarterfinal winner")
+	}
+	final := findMatch(t, repo, domain.BracketWinners, 3, 1)
+	if !isParticipant(final, winnerID) {
+		t.Fatal("expected the bye winner to advance to the final")
+	}
+
+	// Reopening the double forfeit takes the bye back
+	if _, err := matchSvc.ReopenMatch(ctx, r1m1.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi = findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if semi.Status != domain.MatchPending || semi.WinnerID != nil || !isParticipant(semi, winnerID) {
+		t.Errorf("expected the semifinal to wait for the reopened match again, got status %s", semi.Status)
+	}
+	if final = findMatch(t, repo, domain.BracketWinners, 3, 1); isParticipant(final, winnerID) {
+		t.Error("expected the bye winner to be pulled back out of the final")
+	}
+}
+
+func TestDoubleForfeit_WithdrawnParticipantGetsNoBye(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	forfeitSvc := NewForfeitService(repo, newMockSetRepo(), newMockStationRepo(), newMockEventRepo(), nil)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A semifinal winner withdraws while waiting for the final
+	semi1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if err := matchSvc.ReportResult(ctx, semi1.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := forfeitSvc.ProcessWithdrawal(ctx, 1, *semi1.Participant1ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	final := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if final.Participant1Disqualified || !final.Participant1Withdrawn {
+		t.Error("expected the withdrawn participant to be flagged as withdrawn, not disqualified")
+	}
+
+	// Nobody shows up for the other semifinal either, so nobody can win the final
+	semi2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if _, err := forfeitSvc.DoubleForfeit(ctx, semi2.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	final = findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if final.Status != domain.MatchCompleted || final.WinnerID != nil {
+		t.Error("expected the final to complete without a winner")
+	}
+}
+
+func TestWithdrawal_EditedForfeitReopensLosersBye(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	forfeitSvc := NewForfeitService(repo, newMockSetRepo(), newMockStationRepo(), newMockEventRepo(), nil)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateDoubleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// One participant of the first winners match withdraws, so the losers match their
+	// loss would have fed becomes a bye for the other winners match's loser
+	first := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	withdrawn, stays := *first.Participant2ID, *first.Participant1ID
+	if _, err := forfeitSvc.ProcessWithdrawal(ctx, 1, withdrawn); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	other := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if err := matchSvc.ReportResult(ctx, other.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bye := findMatch(t, repo, domain.BracketLosers, 1, 1); bye.Status != domain.MatchCompleted {
+		t.Fatalf("expected the losers match to be settled as a bye, got %s", bye.Status)
+	}
+
+	// The withdrawal was a mistake and the match was played after all
+	result := p2Wins
+	if *first.Participant1ID == withdrawn {
+		result = p1Wins
+	}
+	if _, err := matchSvc.EditResult(ctx, first.ID, result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	losers := findMatch(t, repo, domain.BracketLosers, 1, 1)
+	if losers.Status != domain.MatchReady || losers.WinnerID != nil || !isParticipant(losers, stays) {
+		t.Errorf("expected the bye to be reopened as a ready match for participant %d, got %s", stays, losers.Status)
+	}
+	if next := findMatch(t, repo, domain.BracketLosers, 2, 1); isParticipant(next, *other.Participant2ID) {
+		t.Error("expected the bye winner to be pulled back out of the next losers match")
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// isFirstGrandFinal reports whether a match is the first grand final of a double
+// elimination bracket (the one that may trigger a bracket reset).
+func isFirstGrandFinal(match *domain.Match) bool {
+	return match.BracketType == domain.BracketGrandFinal && match.Round == 1
+}
+
+// syncGrandFinalReset keeps the bracket reset match in line with the first grand final's
+// result. When the losers bracket finalist (slot 2) beats the winners bracket finalist,
+// both have one loss, so a second grand final is created if resetEnabled (see
+// resetSetting). When the winners bracket finalist wins, any reset match is removed.
+func (s *matchService) syncGrandFinalReset(ctx context.Context, grandFinal *domain.Match, winnerID uint64, resetEnabled bool) error {
+	if !isFirstGrandFinal(grandFinal) {
+		return nil
+	}
+
+	reset, err := s.findResetMatch(ctx, grandFinal.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	losersFinalistWon := grandFinal.Participant2ID != nil && *grandFinal.Participant2ID == winnerID
+	if !losersFinalistWon {
+		var reopened []*domain.Match
+		return s.removeResetMatch(ctx, grandFinal.TournamentID, &reopened)
+	}
+
+	if reset != nil || !resetEnabled {
+		return nil
+	}
+
+	// Same participants, same sides: winners bracket finalist stays in slot 1
+	reset = &domain.Match{
+		TournamentID:     grandFinal.TournamentID,
+		Stage:            grandFinal.Stage,
+		BracketType:      domain.BracketGrandFinal,
+		Round:            2,
+		Position:         1,
+		Participant1ID:   grandFinal.Participant1ID,
+		Participant2ID:   grandFinal.Participant2ID,
+		Participant1Name: grandFinal.Participant1Name,
+		Participant2Name: grandFinal.Participant2Name,
+		Seed1:            grandFinal.Seed1,
+		Seed2:            grandFinal.Seed2,
+		Format:           grandFinal.Format,
+		Status:           domain.MatchReady,
+	}
+	return s.repo.CreateBatch(ctx, []*domain.Match{reset})
+}
+
+// removeResetMatch deletes the bracket reset match, if one was created. A reset that
+// was already played is reopened first, so its result is recorded in the audit log as
+// a cascade before the match goes.
+func (s *matchService) removeResetMatch(ctx context.Context, tournamentID uint64, reopened *[]*domain.Match) error {
+	reset, err := s.findResetMatch(ctx, tournamentID)
+	if err != nil || reset == nil {
+		return err
+	}
+	if reset.Status == domain.MatchCompleted {
+		if err := s.reopenMatchCascade(ctx, reset, domain.EventCascade, reopened); err != nil {
+			return err
+		}
+	}
+	return s.repo.Delete(ctx, reset.ID)
+}
+
+// findResetMatch returns the second grand final of a tournament, or nil if there is none.
+func (s *matchService) findResetMatch(ctx context.Context, tournamentID uint64) (*domain.Match, error) {
+	matches, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		if m.BracketType == domain.BracketGrandFinal && m.Round == 2 {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+// resetSetting reads the tournament's bracket reset setting for a match about to get
+// a result, so it is loaded before anything is written. Only the first grand final
+// needs it.
+func (s *matchService) resetSetting(ctx context.Context, match *domain.Match) (bool, error) {
+	if !isFirstGrandFinal(match) {
+		return false, nil
+	}
+	settings, err := loadSettings(ctx, s.tournamentClient, match.TournamentID)
+	if err != nil {
+		return false, err
+	}
+
+	return settings.GrandFinalReset, nil
+}
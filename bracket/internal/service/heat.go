@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+var (
+	ErrNotHeat           = errors.New("match is not a free-for-all heat")
+	ErrHeatMatch         = errors.New("heat results are reported as placements")
+	ErrInvalidPlacements = errors.New("placements must rank every participant in the heat from 1 with no gaps or ties")
+)
+
+type HeatService interface {
+	GenerateFreeForAll(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error)
+	ReportPlacements(ctx context.Context, matchID uint64, placements []domain.Placement) error
+	CheckReporter(ctx context.Context, matchID, userID uint64) error
+}
+
+type heatService struct {
+	repo             repository.MatchRepository
+	slotRepo         repository.SlotRepository
+	stationRepo      repository.StationRepository
+	eventRepo        repository.EventRepository
+	tournamentClient client.TournamentClient
+}
+
+func NewHeatService(
+	repo repository.MatchRepository,
+	slotRepo repository.SlotRepository,
+	stationRepo repository.StationRepository,
+	eventRepo repository.EventRepository,
+	tournamentClient client.TournamentClient,
+) HeatService {
+	return &heatService{
+		repo:             repo,
+		slotRepo:         slotRepo,
+		stationRepo:      stationRepo,
+		eventRepo:        eventRepo,
+		tournamentClient: tournamentClient,
+	}
+}
+
+// GenerateFreeForAll creates a heat-based free-for-all bracket, using the tournament's
+// heat size and advancing count, and persists it.
+func (s *heatService) GenerateFreeForAll(ctx context.Context, tournamentID uint64, participants []domain.Participant) (*BracketState, error) {
+	settings, err := loadSettings(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	heatSize, advance := heatSettings(settings)
+
+	// Generate matches in memory
+	matches, err := engine.FreeForAll(tournamentID, participants, heatSize, advance)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		m.Stage = 1
+	}
+
+	// Save matches to DB (assigns IDs), then their slots
+	if err := s.repo.CreateBatch(ctx, matches); err != nil {
+		return nil, err
+	}
+	var slots []domain.Slot
+	for _, m := range matches {
+		for _, slot := range m.Slots {
+			slot.MatchID = m.ID
+			slots = append(slots, slot)
+		}
+	}
+	if err := s.slotRepo.CreateBatch(ctx, slots); err != nil {
+		return nil, err
+	}
+
+	// Link heats now that we have IDs
+	engine.LinkHeats(matches)
+	if err := s.repo.UpdateNextMatchLinks(ctx, matches); err != nil {
+		return nil, err
+	}
+
+	// Advance heats that had nobody to eliminate
+	for _, m := range matches {
+		if m.Status == domain.MatchCompleted {
+			if err := s.advance(ctx, m, m.Slots); err != nil {
+				return nil, err
+			}
+		}
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, tournamentID)
+
+	// Reload matches to get final state
+	matches, err = s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBracketState(tournamentID, matches, settings), nil
+}
+
+// CheckReporter returns ErrNotReporter unless the user may report the heat's
+// placements: the tournament organizer, or a player seated in the heat.
+func (s *heatService) CheckReporter(ctx context.Context, matchID, userID uint64) error {
+	match, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	tournament, err := s.tournamentClient.GetTournament(ctx, match.TournamentID)
+	if err != nil {
+		return fmt.Errorf("failed to load tournament: %w", err)
+	}
+	if tournament.OrganizerID == userID {
+		return nil
+	}
+
+	slots, err := s.slotRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	for _, slot := range slots {
+		if slot.ParticipantID == nil {
+			continue
+		}
+		participant, err := s.tournamentClient.GetParticipant(ctx, *slot.ParticipantID)
+		if err != nil {
+			return fmt.Errorf("failed to load participant %d: %w", *slot.ParticipantID, err)
+		}
+		if participant.UserID != nil && *participant.UserID == userID {
+			return nil
+		}
+	}
+	return ErrNotReporter
+}
+
+// ReportPlacements records the finishing order of a heat, sends its top finishers on to
+// their next heat and frees the heat's station for the next match in the queue. Every
+// participant in the heat must be placed exactly once, from 1 upwards.
+func (s *heatService) ReportPlacements(ctx context.Context, matchID uint64, placements []domain.Placement) error {
+	match, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+
+	if match.BracketType != domain.BracketHeat {
+		return ErrNotHeat
+	}
+	if match.Status == domain.MatchCompleted {
+		return ErrMatchAlreadyComplete
+	}
+	if match.Status == domain.MatchPending {
+		return ErrMatchNotReady
+	}
+
+	slots, err := s.slotRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if err := validatePlacements(slots, placements); err != nil {
+		return err
+	}
+
+	before := snapshotHeat(match, slots)
+	if err := s.slotRepo.UpdatePlacements(ctx, matchID, placements); err != nil {
+		return err
+	}
+
+	// The heat winner is its first place finisher
+	var winnerID uint64
+	for _, p := range placements {
+		if p.Placement == 1 {
+			winnerID = p.ParticipantID
+		}
+	}
+	if err := s.repo.UpdateResult(ctx, matchID, winnerID); err != nil {
+		return err
+	}
+
+	reported, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	slots, err = s.slotRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if err := createEvent(ctx, s.eventRepo, domain.EventReport, match, before, snapshotHeat(reported, slots)); err != nil {
+		return err
+	}
+	if err := s.advance(ctx, match, slots); err != nil {
+		return err
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, match.TournamentID)
+	releaseStation(ctx, s.repo, s.stationRepo, s.tournamentClient, match)
+	return nil
+}
+
+// snapshotHeat returns a heat's result as it stands: its winner and placements.
+func snapshotHeat(heat *domain.Match, slots []domain.Slot) domain.MatchSnapshot {
+	snapshot := domain.MatchSnapshot{
+		Completed: heat.Status == domain.MatchCompleted,
+		WinnerID:  heat.WinnerID,
+		Sets:      []domain.SetScore{},
+	}
+	for _, slot := range slots {
+		if slot.ParticipantID != nil && slot.Placement != nil {
+			snapshot.Placements = append(snapshot.Placements, domain.Placement{
+				ParticipantID: *slot.ParticipantID,
+				Placement:     *slot.Placement,
+			})
+		}
+	}
+	return snapshot
+}
+
+// advance seats the top finishers of a completed heat in their next heat, which is
+// marked ready once every slot is filled.
+func (s *heatService) advance(ctx context.Context, heat *domain.Match, slots []domain.Slot) error {
+	if heat.NextMatchID == nil || heat.AdvanceCount == 0 {
+		return nil
+	}
+
+	next, err := s.repo.GetByID(ctx, *heat.NextMatchID)
+	if err != nil {
+		return err
+	}
+	nextSlots, err := s.slotRepo.GetByMatchID(ctx, next.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, slot := range slots {
+		if slot.ParticipantID == nil || slot.Placement == nil || *slot.Placement > heat.AdvanceCount {
+			continue
+		}
+		name, seed := "", 0
+		if slot.ParticipantName != nil {
+			name = *slot.ParticipantName
+		}
+		if slot.Seed != nil {
+			seed = *slot.Seed
+		}
+		target := engine.HeatSlot(heat, len(nextSlots), *slot.Placement)
+		if err := s.slotRepo.SetParticipant(ctx, next.ID, target, *slot.ParticipantID, name, seed); err != nil {
+			return err
+		}
+	}
+
+	// Refresh next heat slots to check if they are all filled
+	nextSlots, err = s.slotRepo.GetByMatchID(ctx, next.ID)
+	if err != nil {
+		return err
+	}
+	for _, slot := range nextSlots {
+		if slot.ParticipantID == nil {
+			return nil
+		}
+	}
+	return s.repo.UpdateStatus(ctx, next.ID, domain.MatchReady)
+}
+
+// validatePlacements checks that placements rank each seated participant exactly
+// once, using every position from 1 to the number of participants.
+func validatePlacements(slots []domain.Slot, placements []domain.Placement) error {
+	seated := make(map[uint64]bool)
+	for _, slot := range slots {
+		if slot.ParticipantID != nil {
+			seated[*slot.ParticipantID] = true
+		}
+	}
+	if len(placements) != len(seated) {
+		return ErrInvalidPlacements
+	}
+
+	placed := make(map[uint64]bool)
+	used := make(map[int]bool)
+	for _, p := range placements {
+		if !seated[p.ParticipantID] {
+			return ErrInvalidWinner
+		}
+		if placed[p.ParticipantID] || used[p.Placement] || p.Placement < 1 || p.Placement > len(seated) {
+			return ErrInvalidPlacements
+		}
+		placed[p.ParticipantID] = true
+		used[p.Placement] = true
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// mockSlotRepository implements repository.SlotRepository for testing
+type mockSlotRepository struct {
+	slots map[uint64][]domain.Slot
+}
+
+func newMockSlotRepo() *mockSlotRepository {
+	return &mockSlotRepository{slots: make(map[uint64][]domain.Slot)}
+}
+
+func (r *mockSlotRepository) GetByMatchID(ctx context.Context, matchID uint64) ([]domain.Slot, error) {
+	return append([]domain.Slot(nil), r.slots[matchID]...), nil
+}
+
+func (r *mockSlotRepository) GetByMatchIDs(ctx context.Context, matchIDs []uint64) (map[uint64][]domain.Slot, error) {
+	result := make(map[uint64][]domain.Slot)
+	for _, id := range matchIDs {
+		if slots, ok := r.slots[id]; ok {
+			result[id] = append([]domain.Slot(nil), slots...)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockSlotRepository) CreateBatch(ctx context.Context, slots []domain.Slot) error {
+	for _, s := range slots {
+		r.slots[s.MatchID] = append(r.slots[s.MatchID], s)
+	}
+	return nil
+}
+
+func (r *mockSlotRepository) SetParticipant(ctx context.Context, matchID uint64, slotNumber int, participantID uint64, name string, seed int) error {
+	for i := range r.slots[matchID] {
+		s := &r.slots[matchID][i]
+		if s.SlotNumber == slotNumber {
+			s.ParticipantID, s.ParticipantName, s.Seed = &participantID, &name, &seed
+			return nil
+		}
+	}
+	return errors.New("slot not found")
+}
+
+func (r *mockSlotRepository) UpdatePlacements(ctx context.Context, matchID uint64, placements []domain.Placement) error {
+	for _, p := range placements {
+		for i := range r.slots[matchID] {
+			s := &r.slots[matchID][i]
+			if s.ParticipantID != nil && *s.ParticipantID == p.ParticipantID {
+				placement := p.Placement
+				s.Placement = &placement
+			}
+		}
+	}
+	return nil
+}
+
+// placements ranks participants in the given order.
+func placements(participantIDs ...uint64) []domain.Placement {
+	result := make([]domain.Placement, len(participantIDs))
+	for i, id := range participantIDs {
+		result[i] = domain.Placement{ParticipantID: id, Placement: i + 1}
+	}
+	return result
+}
+
+func TestFreeForAll_PlacementsAdvance(t *testing.T) {
+	repo := newMockRepo()
+	slotRepo := newMockSlotRepo()
+	eventRepo := newMockEventRepo()
+	heatSvc := NewHeatService(repo, slotRepo, newMockStationRepo(), eventRepo, nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	// Snake draft: heat 1 has seeds 1, 4, 5, 8 and heat 2 has seeds 2, 3, 6, 7
+	if _, err := heatSvc.GenerateFreeForAll(ctx, 1, makeParticipants(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	heat1 := findMatch(t, repo, domain.BracketHeat, 1, 1)
+	heat2 := findMatch(t, repo, domain.BracketHeat, 1, 2)
+	final := findMatch(t, repo, domain.BracketHeat, 2, 1)
+
+	if err := matchSvc.ReportResult(ctx, heat1.ID, p1Wins); !errors.Is(err, ErrHeatMatch) {
+		t.Errorf("expected ErrHeatMatch, got %v", err)
+	}
+	if err := heatSvc.ReportPlacements(ctx, heat1.ID, placements(5, 1, 8)); !errors.Is(err, ErrInvalidPlacements) {
+		t.Errorf("expected ErrInvalidPlacements for an unplaced participant, got %v", err)
+	}
+
+	if err := heatSvc.ReportPlacements(ctx, heat1.ID, placements(5, 1, 8, 4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if findMatch(t, repo, domain.BracketHeat, 2, 1).Status != domain.MatchPending {
+		t.Error("final should wait for both heats")
+	}
+	events, _ := eventRepo.GetByMatchID(ctx, heat1.ID)
+	if len(events) != 1 || events[0].Type != domain.EventReport || len(events[0].After.Placements) != 4 {
+		t.Fatalf("expected the placements to be recorded in the audit log, got %+v", events)
+	}
+	if events[0].Before.Completed || *events[0].After.WinnerID != 5 {
+		t.Errorf("expected the heat to go from unplayed to won by participant 5, got %+v", events[0])
+	}
+	if err := heatSvc.ReportPlacements(ctx, heat2.ID, placements(2, 3, 6, 7)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Top two of each heat fill the final in finishing order
+	want := []uint64{5, 1, 2, 3}
+	for i, slot := range slotRepo.slots[final.ID] {
+		if slot.ParticipantID == nil || *slot.ParticipantID != want[i] {
+			t.Errorf("final slot %d: expected participant %d", i+1, want[i])
+		}
+	}
+	if findMatch(t, repo, domain.BracketHeat, 2, 1).Status != domain.MatchReady {
+		t.Fatal("expected the final to be ready")
+	}
+
+	if err := heatSvc.ReportPlacements(ctx, final.ID, placements(3, 5, 1, 2)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	state, _ := matchSvc.GetBracketState(ctx, 1)
+	if !state.IsComplete || *state.ChampionID != 3 {
+		t.Error("expected the final heat winner to be champion")
+	}
+}
+
+func TestCheckReporter_Heat(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{
+		organizerID: 1000,
+		userIDs:     map[uint64]uint64{1: 101, 2: 102, 3: 103, 4: 104, 5: 105, 6: 106, 7: 107, 8: 108},
+	}
+	heatSvc := NewHeatService(repo, newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments)
+	ctx := context.Background()
+
+	// Heat 1 has seeds 1, 4, 5 and 8
+	if _, err := heatSvc.GenerateFreeForAll(ctx, 1, makeParticipants(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	heat := findMatch(t, repo, domain.BracketHeat, 1, 1)
+
+	for _, userID := range []uint64{1000, 104} {
+		if err := heatSvc.CheckReporter(ctx, heat.ID, userID); err != nil {
+			t.Errorf("expected user %d to be allowed to report, got %v", userID, err)
+		}
+	}
+	for _, userID := range []uint64{102, 999} {
+		if err := heatSvc.CheckReporter(ctx, heat.ID, userID); !errors.Is(err, ErrNotReporter) {
+			t.Errorf("expected ErrNotReporter for user %d, got %v", userID, err)
+		}
+	}
+}
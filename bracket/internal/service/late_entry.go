@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+var ErrNoOpenByeSlot = errors.New("no open bye slot for a late entry")
+
+// AddLateEntrant places a participant who arrived after the bracket was generated into
+// an unplayed first round bye, against the participant who got the bye. The bye's
+// auto-advance is undone and the match is left ready. The bye of the lowest seed is
+// used, which is where the entrant would have been seeded had they registered in time.
+// Byes whose winner has already started playing their next match are not open.
+func (s *matchService) AddLateEntrant(ctx context.Context, tournamentID uint64, participant domain.Participant) (*domain.Match, error) {
+	matches, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	bye := openByeSlot(matches)
+	if bye == nil {
+		return nil, ErrNoOpenByeSlot
+	}
+
+	// Pull the bye winner back out of their next match and reopen the bye
+	ctx = withReason(ctx, fmt.Sprintf("participant %d entered late", participant.ID))
+	var reopened []*domain.Match
+	if err := s.reopenMatchCascade(ctx, bye, domain.EventReopen, &reopened); err != nil {
+		return nil, err
+	}
+
+	// The bye sent no loser on, so a loser match it feeds may have been settled as a bye too
+	if bye.LoserMatchID != nil {
+		if err := s.reopenSettledBye(ctx, *bye.LoserMatchID, &reopened); err != nil {
+			return nil, err
+		}
+	}
+
+	slot := 2
+	if bye.Participant1ID == nil {
+		slot = 1
+	}
+	if err := s.repo.SetParticipant(ctx, bye.ID, slot, participant.ID, participant.Name, participant.Seed); err != nil {
+		return nil, err
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, tournamentID)
+
+	return s.repo.GetByID(ctx, bye.ID)
+}
+
+// openByeSlot returns the first round bye a late entrant can take, or nil if there is
+// none: the one whose participant has the lowest seed, among byes that can still be
+// undone without touching a match that has been played.
+func openByeSlot(matches []*domain.Match) *domain.Match {
+	byID := make(map[uint64]*domain.Match, len(matches))
+	for _, m := range matches {
+		byID[m.ID] = m
+	}
+
+	var open *domain.Match
+	for _, m := range matches {
+		if m.BracketType != domain.BracketWinners || m.Round != 1 || m.Stage > 1 {
+			continue
+		}
+		if m.Status != domain.MatchCompleted || m.WinnerID == nil || hasResult(m) {
+			continue
+		}
+		if !unplayed(byID, m.NextMatchID) || !undoableBye(byID, m.LoserMatchID) {
+			continue
+		}
+		if open == nil || byeSeed(m) > byeSeed(open) {
+			open = m
+		}
+	}
+	return open
+}
+
+// unplayed reports whether the match with the given ID (if any) has not started.
+func unplayed(byID map[uint64]*domain.Match, id *uint64) bool {
+	if id == nil {
+		return true
+	}
+	m, ok := byID[*id]
+	return !ok || m.Status == domain.MatchPending || m.Status == domain.MatchReady
+}
+
+// undoableBye reports whether the match with the given ID (if any) has not started, or
+// was settled as a bye whose winner has not started their next match.
+func undoableBye(byID map[uint64]*domain.Match, id *uint64) bool {
+	if unplayed(byID, id) {
+		return true
+	}
+	m := byID[*id]
+	if m.Status != domain.MatchCompleted || hasResult(m) {
+		return false
+	}
+	return m.WinnerID == nil || unplayed(byID, m.NextMatchID)
+}
+
+// byeSeed returns the seed of the participant who got a bye (0 if unseeded).
+func byeSeed(m *domain.Match) int {
+	seed := m.Seed1
+	if m.Participant1ID == nil {
+		seed = m.Seed2
+	}
+	if seed == nil {
+		return 0
+	}
+	return *seed
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func lateEntrant(id uint64, seed int) domain.Participant {
+	return domain.Participant{ID: id, Name: "Late", Seed: seed}
+}
+
+func TestAddLateEntrant_TakesLowestSeedBye(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	// 6 participants in a bracket of 8: seeds 1 and 2 have byes
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(6)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	match, err := matchSvc.AddLateEntrant(ctx, 1, lateEntrant(7, 7))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Seed 7 belongs opposite seed 2
+	if match.Status != domain.MatchReady || match.WinnerID != nil {
+		t.Errorf("expected the bye to be reopened and ready, got %s", match.Status)
+	}
+	if !isParticipant(match, 2) || !isParticipant(match, 7) {
+		t.Error("expected the late entrant to face seed 2")
+	}
+
+	next := repo.matches[*match.NextMatchID]
+	if isParticipant(next, 2) {
+		t.Error("expected seed 2 to be pulled back out of round 2")
+	}
+	if next.Status != domain.MatchPending {
+		t.Errorf("expected round 2 match to be pending, got %s", next.Status)
+	}
+
+	// The result goes through as normal
+	if err := matchSvc.ReportResult(ctx, match.ID, p2Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next = repo.matches[*match.NextMatchID]; !isParticipant(next, 7) {
+		t.Error("expected the late entrant to advance")
+	}
+
+	// Seed 1's bye is still open; once it is taken there are none left
+	if _, err := matchSvc.AddLateEntrant(ctx, 1, lateEntrant(8, 8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := matchSvc.AddLateEntrant(ctx, 1, lateEntrant(9, 9)); !errors.Is(err, ErrNoOpenByeSlot) {
+		t.Errorf("expected ErrNoOpenByeSlot, got %v", err)
+	}
+}
+
+func TestAddLateEntrant_UndoesLosersBye(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	// 3 participants: seed 1 has a bye, so 3 passes through losers round 1 unopposed
+	if _, err := bracketSvc.GenerateDoubleElimination(ctx, 1, makeParticipants(3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if err := matchSvc.ReportResult(ctx, w2.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := matchSvc.AddLateEntrant(ctx, 1, lateEntrant(4, 4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	l1 := findMatch(t, repo, domain.BracketLosers, 1, 1)
+	if l1.Status != domain.MatchPending || l1.WinnerID != nil || !isParticipant(l1, 3) {
+		t.Error("expected seed 3 to wait in losers round 1 for the late entrant's match")
+	}
+	lf := findMatch(t, repo, domain.BracketLosers, 2, 1)
+	if isParticipant(lf, 3) {
+		t.Error("expected seed 3 to be pulled back out of the losers final")
+	}
+}
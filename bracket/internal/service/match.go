@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"log"
+	"time"
 
 	"github.com/braccet/bracket/internal/client"
 	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
 	"github.com/braccet/bracket/internal/repository"
 )
 
@@ -17,6 +19,9 @@ var (
 	ErrNoSets               = errors.New("at least one set is required")
 	ErrSetsTied             = errors.New("sets are tied - there must be a clear winner")
 	ErrMatchNotCompleted    = errors.New("match is not completed")
+	ErrTiedSet              = errors.New("tied sets are not allowed in this match format")
+	ErrSetAfterDecided      = errors.New("sets were reported after the match was already decided")
+	ErrTooFewSets           = errors.New("not enough sets to decide the match under its format")
 )
 
 type MatchService interface {
@@ -24,7 +29,15 @@ type MatchService interface {
 	EditResult(ctx context.Context, matchID uint64, result domain.MatchResult) (*EditResultResponse, error)
 	StartMatch(ctx context.Context, matchID uint64) error
 	GetBracketState(ctx context.Context, tournamentID uint64) (*BracketState, error)
+	GetStandings(ctx context.Context, tournamentID uint64) (*Standings, error)
 	ReopenMatch(ctx context.Context, matchID uint64) ([]*domain.Match, error)
+	AddLateEntrant(ctx context.Context, tournamentID uint64, participant domain.Participant) (*domain.Match, error)
+	SubstituteParticipant(ctx context.Context, tournamentID, participantID uint64, substitute domain.Participant) ([]*domain.Match, error)
+	Disqualify(ctx context.Context, tournamentID, participantID uint64, voidRound bool) (*ForfeitSummary, error)
+	ScheduleMatches(ctx context.Context, tournamentID uint64) ([]*domain.Match, error)
+	CallMatch(ctx context.Context, matchID uint64) (*domain.Match, error)
+	CheckIn(ctx context.Context, matchID, userID uint64, participantID *uint64) (*domain.Match, error)
+	ExpireCalls(ctx context.Context, now time.Time) error
 }
 
 type EditResultResponse struct {
@@ -35,36 +48,53 @@ type EditResultResponse struct {
 
 type BracketState struct {
 	TournamentID uint64
+	Stage        int
 	TotalRounds  int
 	CurrentRound int
 	Matches      []*domain.Match
 	IsComplete   bool
 	ChampionID   *uint64
+
+	// SeedingConflicts lists same-tag pairs that could not be kept apart (generation only)
+	SeedingConflicts []engine.SeedingConflict
+	// Projections holds each match's projected participants, by match ID (previews only)
+	Projections map[uint64]engine.Projection
 }
 
 type matchService struct {
-	repo              repository.MatchRepository
-	setRepo           repository.SetRepository
-	tournamentClient  client.TournamentClient
-	communityClient   client.CommunityClient
+	repo             repository.MatchRepository
+	setRepo          repository.SetRepository
+	slotRepo         repository.SlotRepository
+	stationRepo      repository.StationRepository
+	eventRepo        repository.EventRepository
+	tournamentClient client.TournamentClient
+	communityClient  client.CommunityClient
 }
 
 func NewMatchService(
 	repo repository.MatchRepository,
 	setRepo repository.SetRepository,
+	slotRepo repository.SlotRepository,
+	stationRepo repository.StationRepository,
+	eventRepo repository.EventRepository,
 	tournamentClient client.TournamentClient,
 	communityClient client.CommunityClient,
 ) MatchService {
 	return &matchService{
 		repo:             repo,
 		setRepo:          setRepo,
+		slotRepo:         slotRepo,
+		stationRepo:      stationRepo,
+		eventRepo:        eventRepo,
 		tournamentClient: tournamentClient,
 		communityClient:  communityClient,
 	}
 }
 
-// ReportResult records the result of a match and advances the winner.
-// Winner is computed from the sets (whoever wins the most sets).
+// ReportResult records the result of a match, advances the winner and frees the
+// match's station for the next match in the queue.
+// Winner is computed from the sets (whoever wins the most sets), or taken from the
+// outcome in win/draw/loss matches. A drawn match completes with no winner.
 func (s *matchService) ReportResult(ctx context.Context, matchID uint64, result domain.MatchResult) error {
 	match, err := s.repo.GetByID(ctx, matchID)
 	if err != nil {
@@ -72,6 +102,9 @@ func (s *matchService) ReportResult(ctx context.Context, matchID uint64, result
 	}
 
 	// Validate match can receive a result
+	if match.BracketType == domain.BracketHeat {
+		return ErrHeatMatch
+	}
 	if match.Status == domain.MatchCompleted {
 		return ErrMatchAlreadyComplete
 	}
@@ -79,36 +112,75 @@ func (s *matchService) ReportResult(ctx context.Context, matchID uint64, result
 		return ErrMatchNotReady
 	}
 
-	// Validate sets
-	if len(result.Sets) == 0 {
-		return ErrNoSets
+	// Compute winner from sets (or the outcome)
+	winnerID, err := decideResult(match, result)
+	if err != nil {
+		return err
+	}
+	resetEnabled, err := s.resetSetting(ctx, match)
+	if err != nil {
+		return err
 	}
 
-	// Compute winner from sets
-	winnerID, err := computeWinnerFromSets(match, result.Sets)
+	before, err := snapshotMatch(ctx, s.repo, s.setRepo, matchID)
 	if err != nil {
 		return err
 	}
 
 	// Save the sets
-	if err := s.setRepo.CreateBatch(ctx, matchID, result.Sets); err != nil {
-		return err
+	if len(result.Sets) > 0 {
+		if err := s.setRepo.CreateBatch(ctx, matchID, result.Sets); err != nil {
+			return err
+		}
+	}
+
+	if winnerID == nil {
+		return s.recordDraw(ctx, match, before)
 	}
 
 	// Update the match result with computed winner
-	if err := s.repo.UpdateResult(ctx, matchID, winnerID); err != nil {
+	if err := s.repo.UpdateResult(ctx, matchID, *winnerID); err != nil {
+		return err
+	}
+	if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, domain.EventReport, match, before); err != nil {
 		return err
 	}
 
-	// Advance winner to next match if there is one
-	if match.NextMatchID != nil {
-		if err := s.advanceWinner(ctx, match, winnerID); err != nil {
-			return err
-		}
+	// Advance winner (and loser, in double elimination) to their next matches
+	if err := s.advanceParticipants(ctx, match, *winnerID, resetEnabled); err != nil {
+		return err
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, match.TournamentID)
+
+	// The station the match was played on goes to the next match in the queue
+	releaseStation(ctx, s.repo, s.stationRepo, s.tournamentClient, match)
+
+	// Process ELO update asynchronously (don't fail the match if ELO fails)
+	go s.processEloUpdate(context.Background(), match, *winnerID, false)
+
+	return nil
+}
+
+// recordDraw completes a match as a draw. Nobody advances from a drawn match, but it
+// can still be the last result of a pool stage.
+func (s *matchService) recordDraw(ctx context.Context, match *domain.Match, before domain.MatchSnapshot) error {
+	if err := s.repo.UpdateDraw(ctx, match.ID); err != nil {
+		return err
+	}
+	if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, domain.EventReport, match, before); err != nil {
+		return err
 	}
 
+	if err := startNextStage(ctx, s.repo, s.setRepo, s.tournamentClient, match.TournamentID); err != nil {
+		return err
+	}
+	reschedule(ctx, s.repo, s.tournamentClient, match.TournamentID)
+
+	// The station the match was played on goes to the next match in the queue
+	releaseStation(ctx, s.repo, s.stationRepo, s.tournamentClient, match)
+
 	// Process ELO update asynchronously (don't fail the match if ELO fails)
-	go s.processEloUpdate(context.Background(), match, winnerID)
+	go s.processEloUpdate(context.Background(), match, *match.Participant1ID, true)
 
 	return nil
 }
@@ -121,18 +193,28 @@ func (s *matchService) EditResult(ctx context.Context, matchID uint64, result do
 		return nil, err
 	}
 
-	// Can only edit completed matches
+	// Can only edit completed head-to-head matches
+	if match.BracketType == domain.BracketHeat {
+		return nil, ErrHeatMatch
+	}
 	if match.Status != domain.MatchCompleted {
 		return nil, ErrMatchNotCompleted
 	}
+	if err := ensureStageOpen(ctx, s.repo, match); err != nil {
+		return nil, err
+	}
 
-	// Validate sets
-	if len(result.Sets) == 0 {
-		return nil, ErrNoSets
+	// Compute new winner from sets (or the outcome); nil is a draw
+	newWinnerID, err := decideResult(match, result)
+	if err != nil {
+		return nil, err
+	}
+	resetEnabled, err := s.resetSetting(ctx, match)
+	if err != nil {
+		return nil, err
 	}
 
-	// Compute new winner from sets
-	newWinnerID, err := computeWinnerFromSets(match, result.Sets)
+	before, err := snapshotMatch(ctx, s.repo, s.setRepo, matchID)
 	if err != nil {
 		return nil, err
 	}
@@ -143,50 +225,14 @@ func (s *matchService) EditResult(ctx context.Context, matchID uint64, result do
 	}
 
 	// Check if winner changed
-	winnerChanged := match.WinnerID == nil || *match.WinnerID != newWinnerID
+	winnerChanged := !sameWinner(match.WinnerID, newWinnerID) || match.Draw != (newWinnerID == nil)
 
 	if winnerChanged {
 		response.WinnerChanged = true
 
-		// If old winner was advanced to next match, we need to cascade
-		if match.NextMatchID != nil && match.WinnerID != nil {
-			nextMatch, err := s.repo.GetByID(ctx, *match.NextMatchID)
-			if err != nil {
-				return nil, err
-			}
-
-			// Determine which slot the old winner occupied
-			slot := 1
-			if match.Position%2 == 0 {
-				slot = 2
-			}
-
-			// Check if old winner was actually placed in next match
-			oldWinnerInNextMatch := false
-			if slot == 1 && nextMatch.Participant1ID != nil && *nextMatch.Participant1ID == *match.WinnerID {
-				oldWinnerInNextMatch = true
-			} else if slot == 2 && nextMatch.Participant2ID != nil && *nextMatch.Participant2ID == *match.WinnerID {
-				oldWinnerInNextMatch = true
-			}
-
-			if oldWinnerInNextMatch {
-				// If next match was completed, recursively reopen it first
-				if nextMatch.Status == domain.MatchCompleted {
-					if err := s.reopenMatchCascade(ctx, nextMatch, &response.CascadeMatches); err != nil {
-						return nil, err
-					}
-				}
-
-				// Clear the old winner's slot in next match
-				if err := s.repo.ClearParticipant(ctx, *match.NextMatchID, slot); err != nil {
-					return nil, err
-				}
-
-				// Update next match status
-				if err := s.updateMatchStatusAfterClear(ctx, *match.NextMatchID); err != nil {
-					return nil, err
-				}
-			}
+		// Pull the old winner (and loser) back out of the matches they were sent to
+		if err := s.clearDownstream(ctx, match, &response.CascadeMatches); err != nil {
+			return nil, err
 		}
 	}
 
@@ -194,24 +240,41 @@ func (s *matchService) EditResult(ctx context.Context, matchID uint64, result do
 	if err := s.setRepo.DeleteByMatchID(ctx, match.ID); err != nil {
 		return nil, err
 	}
-	if err := s.setRepo.CreateBatch(ctx, matchID, result.Sets); err != nil {
-		return nil, err
-	}
-
-	// Update match with new winner (also clears forfeit_winner_id if it was a forfeit)
-	if err := s.repo.UpdateResult(ctx, matchID, newWinnerID); err != nil {
-		return nil, err
+	if len(result.Sets) > 0 {
+		if err := s.setRepo.CreateBatch(ctx, matchID, result.Sets); err != nil {
+			return nil, err
+		}
 	}
 
-	// If winner changed and there's a next match, advance the new winner
-	if winnerChanged && match.NextMatchID != nil {
-		// Update match reference with new winner for advanceWinner
-		match.WinnerID = &newWinnerID
-		if err := s.advanceWinner(ctx, match, newWinnerID); err != nil {
+	// A draw has nobody to advance
+	if newWinnerID == nil {
+		if err := s.repo.UpdateDraw(ctx, matchID); err != nil {
+			return nil, err
+		}
+		if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, domain.EventEdit, match, before); err != nil {
 			return nil, err
 		}
+	} else {
+		// Update match with new winner (also clears forfeit_winner_id if it was a forfeit)
+		if err := s.repo.UpdateResult(ctx, matchID, *newWinnerID); err != nil {
+			return nil, err
+		}
+		if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, domain.EventEdit, match, before); err != nil {
+			return nil, err
+		}
+
+		// If winner changed, advance the new winner (and loser)
+		if winnerChanged {
+			// Update match reference with new winner for advanceParticipants
+			match.WinnerID = newWinnerID
+			if err := s.advanceParticipants(ctx, match, *newWinnerID, resetEnabled); err != nil {
+				return nil, err
+			}
+		}
 	}
 
+	reschedule(ctx, s.repo, s.tournamentClient, match.TournamentID)
+
 	// Fetch the updated match to return
 	updatedMatch, err := s.repo.GetByID(ctx, matchID)
 	if err != nil {
@@ -229,7 +292,8 @@ func (s *matchService) EditResult(ctx context.Context, matchID uint64, result do
 	return response, nil
 }
 
-// StartMatch transitions a match from ready to in_progress.
+// StartMatch transitions a match from ready to in_progress. A called match starts
+// once both participants have checked in (see CheckIn), not here.
 func (s *matchService) StartMatch(ctx context.Context, matchID uint64) error {
 	match, err := s.repo.GetByID(ctx, matchID)
 	if err != nil {
@@ -250,93 +314,70 @@ func (s *matchService) GetBracketState(ctx context.Context, tournamentID uint64)
 		return nil, err
 	}
 
-	if len(matches) == 0 {
-		return &BracketState{TournamentID: tournamentID}, nil
+	// Only formats decided by standings depend on tournament settings
+	current := engine.StageMatches(matches, engine.CurrentStage(matches))
+	if !engine.IsRoundRobin(current) && !engine.IsSwiss(current) {
+		return buildBracketState(tournamentID, matches, client.TournamentSettings{}), nil
 	}
 
-	state := &BracketState{
-		TournamentID: tournamentID,
-		Matches:      matches,
-	}
-
-	// Find total rounds and current round
-	for _, m := range matches {
-		if m.Round > state.TotalRounds {
-			state.TotalRounds = m.Round
-		}
+	settings, err := loadSettings(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Current round is the lowest round with non-completed matches
-	state.CurrentRound = state.TotalRounds
-	for _, m := range matches {
-		if m.Status != domain.MatchCompleted && m.Round < state.CurrentRound {
-			state.CurrentRound = m.Round
-		}
-	}
+	state := buildBracketState(tournamentID, matches, settings)
 
-	// Check if complete (final match has winner)
-	for _, m := range matches {
-		if m.Round == state.TotalRounds && m.WinnerID != nil {
-			state.IsComplete = true
-			state.ChampionID = m.WinnerID
-			break
+	// A finished round robin or Swiss event is won by whoever tops the standings
+	if state.IsComplete {
+		standings, err := s.standings(ctx, tournamentID, matches, settings)
+		if err != nil {
+			return nil, err
 		}
+		state.ChampionID = &standings.Entries[0].ParticipantID
 	}
 
 	return state, nil
 }
 
-// advanceWinner places the winner into their next match.
-func (s *matchService) advanceWinner(ctx context.Context, completedMatch *domain.Match, winnerID uint64) error {
-	nextMatch, err := s.repo.GetByID(ctx, *completedMatch.NextMatchID)
-	if err != nil {
+// advanceParticipants sends the winner of a completed match to its next match and,
+// in double elimination, the loser to its loser match. Any byes this opens up
+// downstream (e.g. a losers bracket slot that will never be filled) are settled, and
+// a completed pool stage starts the next stage. resetEnabled is the bracket reset
+// setting, which only matters for the first grand final.
+func (s *matchService) advanceParticipants(ctx context.Context, completedMatch *domain.Match, winnerID uint64, resetEnabled bool) error {
+	if err := s.advanceWinner(ctx, completedMatch, winnerID); err != nil {
 		return err
 	}
 
-	// Determine winner's name and seed
-	winnerName := ""
-	winnerSeed := 0
-	if completedMatch.Participant1ID != nil && *completedMatch.Participant1ID == winnerID {
-		if completedMatch.Participant1Name != nil {
-			winnerName = *completedMatch.Participant1Name
-		}
-		if completedMatch.Seed1 != nil {
-			winnerSeed = *completedMatch.Seed1
-		}
-	} else {
-		if completedMatch.Participant2Name != nil {
-			winnerName = *completedMatch.Participant2Name
-		}
-		if completedMatch.Seed2 != nil {
-			winnerSeed = *completedMatch.Seed2
+	if completedMatch.LoserMatchID != nil {
+		if loserID := opponentOf(completedMatch, winnerID); loserID != nil {
+			if err := placeParticipant(ctx, s.repo, completedMatch, *loserID, *completedMatch.LoserMatchID, true); err != nil {
+				return err
+			}
 		}
 	}
 
-	// Determine which slot in the next match (based on position in current round)
-	// Odd positions go to slot 1, even positions go to slot 2
-	slot := 1
-	if completedMatch.Position%2 == 0 {
-		slot = 2
+	if isFirstGrandFinal(completedMatch) {
+		if err := s.syncGrandFinalReset(ctx, completedMatch, winnerID, resetEnabled); err != nil {
+			return err
+		}
 	}
 
-	if err := s.repo.SetParticipant(ctx, nextMatch.ID, slot, winnerID, winnerName, winnerSeed); err != nil {
+	if err := settleByes(ctx, s.repo, completedMatch.TournamentID); err != nil {
 		return err
 	}
 
-	// Refresh next match to check if both participants are now set
-	nextMatch, err = s.repo.GetByID(ctx, nextMatch.ID)
-	if err != nil {
-		return err
-	}
+	// The last result of a pool stage seeds the next stage
+	return startNextStage(ctx, s.repo, s.setRepo, s.tournamentClient, completedMatch.TournamentID)
+}
 
-	// If both participants are set, mark as ready
-	if nextMatch.Participant1ID != nil && nextMatch.Participant2ID != nil {
-		if err := s.repo.UpdateStatus(ctx, nextMatch.ID, domain.MatchReady); err != nil {
-			return err
-		}
+// advanceWinner places the winner into their next match. Matches without a next match
+// (finals, round robin matches) have nowhere to advance to.
+func (s *matchService) advanceWinner(ctx context.Context, completedMatch *domain.Match, winnerID uint64) error {
+	if completedMatch.NextMatchID == nil {
+		return nil
 	}
-
-	return nil
+	return placeParticipant(ctx, s.repo, completedMatch, winnerID, *completedMatch.NextMatchID, false)
 }
 
 func isParticipant(match *domain.Match, participantID uint64) bool {
@@ -350,19 +391,31 @@ func isParticipant(match *domain.Match, participantID uint64) bool {
 }
 
 // computeWinnerFromSets determines the winner based on sets won.
-// Returns the winner ID or an error if sets are tied.
+// Returns the winner ID or an error if sets are tied. Sets must be possible under the
+// match's format: no tied sets unless allowed, no sets after someone has clinched the
+// match (or best of has been played out), and enough sets to get there.
 func computeWinnerFromSets(match *domain.Match, sets []domain.SetScore) (uint64, error) {
 	var p1Wins, p2Wins int
+	limited := match.Format.BestOf > 0 || match.Format.SetsToWin > 0
 
-	for _, set := range sets {
+	for i, set := range sets {
+		if matchDecided(match.Format, p1Wins, p2Wins, i) {
+			return 0, ErrSetAfterDecided
+		}
 		if set.Participant1Score > set.Participant2Score {
 			p1Wins++
 		} else if set.Participant2Score > set.Participant1Score {
 			p2Wins++
+		} else if limited && !match.Format.AllowTiedSets {
+			return 0, ErrTiedSet
 		}
 		// Tied sets don't count for either participant
 	}
 
+	if limited && !matchDecided(match.Format, p1Wins, p2Wins, len(sets)) {
+		return 0, ErrTooFewSets
+	}
+
 	if p1Wins > p2Wins && match.Participant1ID != nil {
 		return *match.Participant1ID, nil
 	}
@@ -373,6 +426,15 @@ func computeWinnerFromSets(match *domain.Match, sets []domain.SetScore) (uint64,
 	return 0, ErrSetsTied
 }
 
+// matchDecided reports whether no more sets can be played under a format: someone
+// has won enough sets to clinch it, or every set of best of has been played.
+func matchDecided(format domain.MatchFormat, p1Wins, p2Wins, played int) bool {
+	if format.SetsToWin > 0 && (p1Wins >= format.SetsToWin || p2Wins >= format.SetsToWin) {
+		return true
+	}
+	return format.BestOf > 0 && played >= format.BestOf
+}
+
 // CountSetsWon returns the number of sets won by each participant.
 func CountSetsWon(sets []domain.Set) (p1Sets, p2Sets int) {
 	for _, set := range sets {
@@ -387,7 +449,8 @@ func CountSetsWon(sets []domain.Set) (p1Sets, p2Sets int) {
 
 // processEloUpdate handles ELO rating updates after a match completion.
 // This runs asynchronously and logs errors rather than failing the match.
-func (s *matchService) processEloUpdate(ctx context.Context, match *domain.Match, winnerID uint64) {
+// For a draw, winnerID is either participant.
+func (s *matchService) processEloUpdate(ctx context.Context, match *domain.Match, winnerID uint64, draw bool) {
 	// Skip if clients are not configured
 	if s.tournamentClient == nil || s.communityClient == nil {
 		return
@@ -452,6 +515,7 @@ func (s *matchService) processEloUpdate(ctx context.Context, match *domain.Match
 		TournamentID:   match.TournamentID,
 		WinnerMemberID: *winnerParticipant.CommunityMemberID,
 		LoserMemberID:  *loserParticipant.CommunityMemberID,
+		Draw:           draw,
 	})
 	if err != nil {
 		log.Printf("ELO: failed to process match %d: %v", match.ID, err)
@@ -473,60 +537,37 @@ func (s *matchService) ReopenMatch(ctx context.Context, matchID uint64) ([]*doma
 		return nil, err
 	}
 
+	if match.BracketType == domain.BracketHeat {
+		return nil, ErrHeatMatch
+	}
 	if match.Status != domain.MatchCompleted {
 		return nil, ErrMatchNotCompleted
 	}
+	if err := ensureStageOpen(ctx, s.repo, match); err != nil {
+		return nil, err
+	}
 
 	reopenedMatches := []*domain.Match{}
 
-	if err := s.reopenMatchCascade(ctx, match, &reopenedMatches); err != nil {
+	if err := s.reopenMatchCascade(ctx, match, domain.EventReopen, &reopenedMatches); err != nil {
 		return nil, err
 	}
+	reschedule(ctx, s.repo, s.tournamentClient, match.TournamentID)
 
 	return reopenedMatches, nil
 }
 
 // reopenMatchCascade recursively reopens a match and all downstream affected matches.
-func (s *matchService) reopenMatchCascade(ctx context.Context, match *domain.Match, reopened *[]*domain.Match) error {
-	// If this match has a next match and had a winner, handle cascade
-	if match.NextMatchID != nil && match.WinnerID != nil {
-		nextMatch, err := s.repo.GetByID(ctx, *match.NextMatchID)
-		if err != nil {
-			return err
-		}
-
-		// Determine which slot the winner occupied in next match
-		slot := 1
-		if match.Position%2 == 0 {
-			slot = 2
-		}
-
-		// Check if winner was actually placed in next match
-		winnerInNextMatch := false
-		if slot == 1 && nextMatch.Participant1ID != nil && *nextMatch.Participant1ID == *match.WinnerID {
-			winnerInNextMatch = true
-		} else if slot == 2 && nextMatch.Participant2ID != nil && *nextMatch.Participant2ID == *match.WinnerID {
-			winnerInNextMatch = true
-		}
-
-		if winnerInNextMatch {
-			// If next match was completed, recursively reopen it first
-			if nextMatch.Status == domain.MatchCompleted {
-				if err := s.reopenMatchCascade(ctx, nextMatch, reopened); err != nil {
-					return err
-				}
-			}
-
-			// Clear the participant slot in next match
-			if err := s.repo.ClearParticipant(ctx, *match.NextMatchID, slot); err != nil {
-				return err
-			}
+// The match is recorded in the audit log as eventType; downstream matches as cascades.
+func (s *matchService) reopenMatchCascade(ctx context.Context, match *domain.Match, eventType domain.MatchEventType, reopened *[]*domain.Match) error {
+	before, err := snapshotMatch(ctx, s.repo, s.setRepo, match.ID)
+	if err != nil {
+		return err
+	}
 
-			// Update next match status
-			if err := s.updateMatchStatusAfterClear(ctx, *match.NextMatchID); err != nil {
-				return err
-			}
-		}
+	// Pull this match's winner (and loser) back out of downstream matches
+	if err := s.clearDownstream(ctx, match, reopened); err != nil {
+		return err
 	}
 
 	// Delete sets for this match
@@ -538,6 +579,9 @@ func (s *matchService) reopenMatchCascade(ctx context.Context, match *domain.Mat
 	if err := s.repo.ReopenMatch(ctx, match.ID); err != nil {
 		return err
 	}
+	if err := recordEvent(ctx, s.repo, s.setRepo, s.eventRepo, eventType, match, before); err != nil {
+		return err
+	}
 
 	// Track this match as reopened (update local state for return)
 	match.WinnerID = nil
@@ -549,6 +593,111 @@ func (s *matchService) reopenMatchCascade(ctx context.Context, match *domain.Mat
 	return nil
 }
 
+// clearDownstream removes a completed match's winner from its next match and, in
+// double elimination, its loser from its loser match (or the bracket reset it caused).
+// Downstream matches that were already played are reopened first (recursively).
+// A match that finished without a winner (a double forfeit) sent nobody on, so the
+// byes it caused downstream are reopened instead.
+func (s *matchService) clearDownstream(ctx context.Context, match *domain.Match, reopened *[]*domain.Match) error {
+	if match.WinnerID == nil {
+		if match.NextMatchID != nil {
+			if err := s.reopenSettledBye(ctx, *match.NextMatchID, reopened); err != nil {
+				return err
+			}
+		}
+		if match.LoserMatchID != nil {
+			return s.reopenSettledBye(ctx, *match.LoserMatchID, reopened)
+		}
+		return nil
+	}
+
+	// A bracket reset only exists because of the first grand final's result
+	if isFirstGrandFinal(match) {
+		if err := s.removeResetMatch(ctx, match.TournamentID, reopened); err != nil {
+			return err
+		}
+	}
+
+	if match.NextMatchID != nil {
+		if err := s.removeFromMatch(ctx, match, *match.NextMatchID, *match.WinnerID, false, reopened); err != nil {
+			return err
+		}
+	}
+
+	if match.LoserMatchID != nil {
+		if loserID := opponentOf(match, *match.WinnerID); loserID != nil {
+			if err := s.removeFromMatch(ctx, match, *match.LoserMatchID, *loserID, true, reopened); err != nil {
+				return err
+			}
+		}
+
+		// A forfeit does not drop the loser, so the slot they would have taken may
+		// have been settled as a bye. Reopen it for whoever loses the match now
+		if match.ForfeitWinnerID != nil {
+			if err := s.reopenSettledBye(ctx, *match.LoserMatchID, reopened); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeFromMatch clears a participant sent from a completed match out of the slot
+// they took in a downstream match, reopening the downstream match first if needed.
+func (s *matchService) removeFromMatch(ctx context.Context, from *domain.Match, targetID, participantID uint64, loser bool, reopened *[]*domain.Match) error {
+	target, err := s.repo.GetByID(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	// Determine which slot the participant occupied in the target match
+	slot := engine.WinnerSlot(from, target)
+	if loser {
+		slot = engine.LoserSlot(from, target)
+	}
+
+	// Check if participant was actually placed in the target match
+	occupant := target.Participant1ID
+	if slot == 2 {
+		occupant = target.Participant2ID
+	}
+	if occupant == nil || *occupant != participantID {
+		return nil
+	}
+
+	// If target match was completed, recursively reopen it first
+	if target.Status == domain.MatchCompleted {
+		if err := s.reopenMatchCascade(ctx, target, domain.EventCascade, reopened); err != nil {
+			return err
+		}
+	}
+
+	// Clear the participant slot in target match
+	if err := s.repo.ClearParticipant(ctx, targetID, slot); err != nil {
+		return err
+	}
+
+	// Update target match status
+	return s.updateMatchStatusAfterClear(ctx, targetID)
+}
+
+// reopenSettledBye reopens a match that settleByes completed because a slot it was
+// waiting on would never be filled, leaving it pending for that slot again.
+func (s *matchService) reopenSettledBye(ctx context.Context, matchID uint64, reopened *[]*domain.Match) error {
+	target, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if target.Status != domain.MatchCompleted || hasResult(target) {
+		return nil
+	}
+	if err := s.reopenMatchCascade(ctx, target, domain.EventCascade, reopened); err != nil {
+		return err
+	}
+	return s.updateMatchStatusAfterClear(ctx, target.ID)
+}
+
 // updateMatchStatusAfterClear updates a match's status after a participant is cleared.
 func (s *matchService) updateMatchStatusAfterClear(ctx context.Context, matchID uint64) error {
 	match, err := s.repo.GetByID(ctx, matchID)
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/braccet/bracket/internal/client"
 	"github.com/braccet/bracket/internal/domain"
 	"github.com/braccet/bracket/internal/repository"
 )
@@ -25,7 +28,8 @@ func (r *mockMatchRepository) CreateBatch(ctx context.Context, matches []*domain
 	for _, m := range matches {
 		m.ID = r.nextID
 		r.nextID++
-		r.matches[m.ID] = m
+		stored := *m
+		r.matches[m.ID] = &stored
 	}
 	return nil
 }
@@ -44,20 +48,33 @@ func (r *mockMatchRepository) GetByTournament(ctx context.Context, tournamentID
 	var matches []*domain.Match
 	for _, m := range r.matches {
 		if m.TournamentID == tournamentID {
-			matches = append(matches, m)
+			copy := *m
+			matches = append(matches, &copy)
 		}
 	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
 	return matches, nil
 }
 
-func (r *mockMatchRepository) UpdateResult(ctx context.Context, matchID uint64, result domain.MatchResult) error {
+func (r *mockMatchRepository) GetPendingByParticipant(ctx context.Context, tournamentID, participantID uint64) ([]*domain.Match, error) {
+	matches, _ := r.GetByTournament(ctx, tournamentID)
+	var pending []*domain.Match
+	for _, m := range matches {
+		if m.Status != domain.MatchCompleted && isParticipant(m, participantID) {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+func (r *mockMatchRepository) UpdateResult(ctx context.Context, matchID uint64, winnerID uint64) error {
 	m, ok := r.matches[matchID]
 	if !ok {
 		return repository.ErrMatchNotFound
 	}
-	m.WinnerID = &result.WinnerID
-	m.Participant1Score = &result.Participant1Score
-	m.Participant2Score = &result.Participant2Score
+	m.WinnerID = &winnerID
+	m.ForfeitWinnerID = nil
+	m.Draw = false
 	m.Status = domain.MatchCompleted
 	return nil
 }
@@ -71,7 +88,43 @@ func (r *mockMatchRepository) UpdateStatus(ctx context.Context, matchID uint64,
 	return nil
 }
 
-func (r *mockMatchRepository) SetParticipant(ctx context.Context, matchID uint64, slot int, participantID uint64, name string) error {
+func (r *mockMatchRepository) UpdateForfeit(ctx context.Context, matchID uint64, winnerID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return repository.ErrMatchNotFound
+	}
+	m.WinnerID = &winnerID
+	m.ForfeitWinnerID = &winnerID
+	m.Draw = false
+	m.Status = domain.MatchCompleted
+	return nil
+}
+
+func (r *mockMatchRepository) CompleteWithoutWinner(ctx context.Context, matchID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return repository.ErrMatchNotFound
+	}
+	m.WinnerID = nil
+	m.ForfeitWinnerID = nil
+	m.Draw = false
+	m.Status = domain.MatchCompleted
+	return nil
+}
+
+func (r *mockMatchRepository) UpdateDraw(ctx context.Context, matchID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return repository.ErrMatchNotFound
+	}
+	m.WinnerID = nil
+	m.ForfeitWinnerID = nil
+	m.Draw = true
+	m.Status = domain.MatchCompleted
+	return nil
+}
+
+func (r *mockMatchRepository) SetParticipant(ctx context.Context, matchID uint64, slot int, participantID uint64, name string, seed int) error {
 	m, ok := r.matches[matchID]
 	if !ok {
 		return repository.ErrMatchNotFound
@@ -79,9 +132,13 @@ func (r *mockMatchRepository) SetParticipant(ctx context.Context, matchID uint64
 	if slot == 1 {
 		m.Participant1ID = &participantID
 		m.Participant1Name = &name
+		m.Seed1 = &seed
+		m.Participant1CheckedIn = false
 	} else {
 		m.Participant2ID = &participantID
 		m.Participant2Name = &name
+		m.Seed2 = &seed
+		m.Participant2CheckedIn = false
 	}
 	return nil
 }
@@ -96,6 +153,216 @@ func (r *mockMatchRepository) UpdateNextMatchLinks(ctx context.Context, matches
 	return nil
 }
 
+func (r *mockMatchRepository) UpdateSchedule(ctx context.Context, schedule map[uint64]time.Time) error {
+	for matchID, scheduledAt := range schedule {
+		if m, ok := r.matches[matchID]; ok {
+			m.ScheduledAt = &scheduledAt
+		}
+	}
+	return nil
+}
+
+func (r *mockMatchRepository) CallMatch(ctx context.Context, matchID uint64, deadline time.Time) error {
+	m, ok := r.matches[matchID]
+	if !ok || m.Status != domain.MatchReady {
+		return repository.ErrMatchStatusChanged
+	}
+	m.Status = domain.MatchCalled
+	m.CheckInDeadline = &deadline
+	m.Participant1CheckedIn = false
+	m.Participant2CheckedIn = false
+	return nil
+}
+
+func (r *mockMatchRepository) CheckIn(ctx context.Context, matchID uint64, slot int) (bool, bool, error) {
+	m, ok := r.matches[matchID]
+	if !ok || m.Status != domain.MatchCalled {
+		return false, false, repository.ErrMatchStatusChanged
+	}
+	if slot == 1 {
+		m.Participant1CheckedIn = true
+	} else {
+		m.Participant2CheckedIn = true
+	}
+	return m.Participant1CheckedIn, m.Participant2CheckedIn, nil
+}
+
+func (r *mockMatchRepository) GetExpiredCalls(ctx context.Context, now time.Time) ([]*domain.Match, error) {
+	var matches []*domain.Match
+	for _, m := range r.matches {
+		if m.Status == domain.MatchCalled && m.CheckInDeadline != nil && !m.CheckInDeadline.After(now) {
+			matches = append(matches, m)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches, nil
+}
+
+func (r *mockMatchRepository) ReopenMatch(ctx context.Context, matchID uint64) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return repository.ErrMatchNotFound
+	}
+	m.WinnerID = nil
+	m.ForfeitWinnerID = nil
+	m.Draw = false
+	m.Status = domain.MatchReady
+	m.CheckInDeadline = nil
+	m.Participant1CheckedIn = false
+	m.Participant2CheckedIn = false
+	return nil
+}
+
+func (r *mockMatchRepository) ClearParticipant(ctx context.Context, matchID uint64, slot int) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return repository.ErrMatchNotFound
+	}
+	if slot == 1 {
+		m.Participant1ID = nil
+		m.Participant1Name = nil
+		m.Participant1Disqualified = false
+		m.Participant1Withdrawn = false
+	} else {
+		m.Participant2ID = nil
+		m.Participant2Name = nil
+		m.Participant2Disqualified = false
+		m.Participant2Withdrawn = false
+	}
+	return nil
+}
+
+func (r *mockMatchRepository) Delete(ctx context.Context, matchID uint64) error {
+	if _, ok := r.matches[matchID]; !ok {
+		return repository.ErrMatchNotFound
+	}
+	delete(r.matches, matchID)
+	return nil
+}
+
+func (r *mockMatchRepository) SetDisqualified(ctx context.Context, matchID uint64, slot int) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return repository.ErrMatchNotFound
+	}
+	if slot == 1 {
+		m.Participant1Disqualified = true
+	} else {
+		m.Participant2Disqualified = true
+	}
+	return nil
+}
+
+func (r *mockMatchRepository) SetWithdrawn(ctx context.Context, matchID uint64, slot int) error {
+	m, ok := r.matches[matchID]
+	if !ok {
+		return repository.ErrMatchNotFound
+	}
+	if slot == 1 {
+		m.Participant1Withdrawn = true
+	} else {
+		m.Participant2Withdrawn = true
+	}
+	return nil
+}
+
+func (r *mockMatchRepository) ReplaceByTournament(ctx context.Context, tournamentID uint64, byes []uint64, matches []*domain.Match) error {
+	completed := make(map[uint64]bool)
+	for _, m := range r.matches {
+		if m.TournamentID == tournamentID && m.Status == domain.MatchCompleted {
+			completed[m.ID] = true
+		}
+	}
+	if len(completed) != len(byes) {
+		return repository.ErrMatchStatusChanged
+	}
+	for _, id := range byes {
+		if !completed[id] {
+			return repository.ErrMatchStatusChanged
+		}
+	}
+
+	for id, m := range r.matches {
+		if m.TournamentID == tournamentID {
+			delete(r.matches, id)
+		}
+	}
+
+	newIDs := make(map[uint64]uint64, len(matches))
+	for _, m := range matches {
+		newIDs[m.ID] = r.nextID
+		r.nextID++
+	}
+	remap := func(id *uint64) *uint64 {
+		if id == nil {
+			return nil
+		}
+		newID := newIDs[*id]
+		return &newID
+	}
+	for _, m := range matches {
+		m.ID = newIDs[m.ID]
+		m.TournamentID = tournamentID
+		m.NextMatchID = remap(m.NextMatchID)
+		m.LoserMatchID = remap(m.LoserMatchID)
+		stored := *m
+		r.matches[m.ID] = &stored
+	}
+	return nil
+}
+
+// mockSetRepository implements repository.SetRepository for testing
+type mockSetRepository struct {
+	sets map[uint64][]domain.Set
+}
+
+func newMockSetRepo() *mockSetRepository {
+	return &mockSetRepository{sets: make(map[uint64][]domain.Set)}
+}
+
+func (r *mockSetRepository) GetByMatchID(ctx context.Context, matchID uint64) ([]domain.Set, error) {
+	return r.sets[matchID], nil
+}
+
+func (r *mockSetRepository) GetByMatchIDs(ctx context.Context, matchIDs []uint64) (map[uint64][]domain.Set, error) {
+	result := make(map[uint64][]domain.Set)
+	for _, id := range matchIDs {
+		if sets, ok := r.sets[id]; ok {
+			result[id] = sets
+		}
+	}
+	return result, nil
+}
+
+func (r *mockSetRepository) CreateBatch(ctx context.Context, matchID uint64, sets []domain.SetScore) error {
+	stored := make([]domain.Set, len(sets))
+	for i, s := range sets {
+		stored[i] = domain.Set{
+			MatchID:           matchID,
+			SetNumber:         s.SetNumber,
+			Participant1Score: s.Participant1Score,
+			Participant2Score: s.Participant2Score,
+		}
+	}
+	r.sets[matchID] = stored
+	return nil
+}
+
+func (r *mockSetRepository) DeleteByMatchID(ctx context.Context, matchID uint64) error {
+	delete(r.sets, matchID)
+	return nil
+}
+
+func newTestMatchService(repo *mockMatchRepository) MatchService {
+	return NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), nil, nil)
+}
+
+// Results where participant 1 or participant 2 wins a single set
+var (
+	p1Wins = domain.MatchResult{Sets: []domain.SetScore{{SetNumber: 1, Participant1Score: 2, Participant2Score: 0}}}
+	p2Wins = domain.MatchResult{Sets: []domain.SetScore{{SetNumber: 1, Participant1Score: 0, Participant2Score: 2}}}
+)
+
 // Helper to create a simple 4-player bracket for testing
 func createTestBracket(repo *mockMatchRepository) []*domain.Match {
 	p1, p2, p3, p4 := uint64(1), uint64(2), uint64(3), uint64(4)
@@ -125,11 +392,11 @@ func createTestBracket(repo *mockMatchRepository) []*domain.Match {
 			BracketType:      domain.BracketWinners,
 		},
 		{
-			TournamentID:     1,
-			Round:            2,
-			Position:         1,
-			Status:           domain.MatchPending,
-			BracketType:      domain.BracketWinners,
+			TournamentID: 1,
+			Round:        2,
+			Position:     1,
+			Status:       domain.MatchPending,
+			BracketType:  domain.BracketWinners,
 		},
 	}
 
@@ -146,17 +413,11 @@ func createTestBracket(repo *mockMatchRepository) []*domain.Match {
 func TestReportResult_Success(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	// Report result for match 1 (seed 1 vs seed 4)
-	result := domain.MatchResult{
-		WinnerID:          1,
-		Participant1Score: 2,
-		Participant2Score: 0,
-	}
-
-	err := svc.ReportResult(ctx, 1, result)
+	err := svc.ReportResult(ctx, 1, p1Wins)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -180,14 +441,14 @@ func TestReportResult_Success(t *testing.T) {
 func TestReportResult_BothMatchesComplete_FinalReady(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	// Report result for match 1
-	svc.ReportResult(ctx, 1, domain.MatchResult{WinnerID: 1})
+	svc.ReportResult(ctx, 1, p1Wins)
 
 	// Report result for match 2
-	err := svc.ReportResult(ctx, 2, domain.MatchResult{WinnerID: 2})
+	err := svc.ReportResult(ctx, 2, p1Wins)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -205,30 +466,32 @@ func TestReportResult_BothMatchesComplete_FinalReady(t *testing.T) {
 	}
 }
 
-func TestReportResult_InvalidWinner(t *testing.T) {
+func TestReportResult_TiedSets(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
-	// Try to report with invalid winner
-	result := domain.MatchResult{WinnerID: 999}
+	// Try to report sets with no clear winner
+	result := domain.MatchResult{Sets: []domain.SetScore{
+		{SetNumber: 1, Participant1Score: 2, Participant2Score: 0},
+		{SetNumber: 2, Participant1Score: 0, Participant2Score: 2},
+	}}
 	err := svc.ReportResult(ctx, 1, result)
 
-	if err != ErrInvalidWinner {
-		t.Errorf("expected ErrInvalidWinner, got %v", err)
+	if err != ErrSetsTied {
+		t.Errorf("expected ErrSetsTied, got %v", err)
 	}
 }
 
 func TestReportResult_MatchNotReady(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	// Try to report result for pending match (the final)
-	result := domain.MatchResult{WinnerID: 1}
-	err := svc.ReportResult(ctx, 3, result)
+	err := svc.ReportResult(ctx, 3, p1Wins)
 
 	if err != ErrMatchNotReady {
 		t.Errorf("expected ErrMatchNotReady, got %v", err)
@@ -238,14 +501,14 @@ func TestReportResult_MatchNotReady(t *testing.T) {
 func TestReportResult_AlreadyComplete(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	// Report result once
-	svc.ReportResult(ctx, 1, domain.MatchResult{WinnerID: 1})
+	svc.ReportResult(ctx, 1, p1Wins)
 
 	// Try to report again
-	err := svc.ReportResult(ctx, 1, domain.MatchResult{WinnerID: 4})
+	err := svc.ReportResult(ctx, 1, p2Wins)
 
 	if err != ErrMatchAlreadyComplete {
 		t.Errorf("expected ErrMatchAlreadyComplete, got %v", err)
@@ -255,7 +518,7 @@ func TestReportResult_AlreadyComplete(t *testing.T) {
 func TestStartMatch(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	// Start match 1
@@ -273,7 +536,7 @@ func TestStartMatch(t *testing.T) {
 func TestStartMatch_NotReady(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	// Try to start the final (which is pending)
@@ -287,7 +550,7 @@ func TestStartMatch_NotReady(t *testing.T) {
 func TestGetBracketState_Initial(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	state, err := svc.GetBracketState(ctx, 1)
@@ -312,13 +575,13 @@ func TestGetBracketState_Initial(t *testing.T) {
 func TestGetBracketState_Complete(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	// Complete all matches
-	svc.ReportResult(ctx, 1, domain.MatchResult{WinnerID: 1})
-	svc.ReportResult(ctx, 2, domain.MatchResult{WinnerID: 2})
-	svc.ReportResult(ctx, 3, domain.MatchResult{WinnerID: 1})
+	svc.ReportResult(ctx, 1, p1Wins)
+	svc.ReportResult(ctx, 2, p1Wins)
+	svc.ReportResult(ctx, 3, p1Wins)
 
 	state, _ := svc.GetBracketState(ctx, 1)
 
@@ -333,14 +596,14 @@ func TestGetBracketState_Complete(t *testing.T) {
 func TestReportResult_InProgressMatch(t *testing.T) {
 	repo := newMockRepo()
 	createTestBracket(repo)
-	svc := NewMatchService(repo)
+	svc := newTestMatchService(repo)
 	ctx := context.Background()
 
 	// Start match first
 	svc.StartMatch(ctx, 1)
 
 	// Now report result
-	err := svc.ReportResult(ctx, 1, domain.MatchResult{WinnerID: 1})
+	err := svc.ReportResult(ctx, 1, p1Wins)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -350,3 +613,118 @@ func TestReportResult_InProgressMatch(t *testing.T) {
 		t.Errorf("expected completed, got %s", match.Status)
 	}
 }
+
+func TestComputeWinnerFromSets_Format(t *testing.T) {
+	p1, p2 := uint64(1), uint64(2)
+	bestOf5 := domain.MatchFormat{BestOf: 5, SetsToWin: 3}
+	set := func(s1, s2 int) domain.SetScore {
+		return domain.SetScore{Participant1Score: s1, Participant2Score: s2}
+	}
+
+	tests := []struct {
+		name    string
+		format  domain.MatchFormat
+		sets    []domain.SetScore
+		wantErr error
+	}{
+		{"no limits accepts a single set", domain.MatchFormat{}, []domain.SetScore{set(2, 0)}, nil},
+		{"clinched in three", bestOf5, []domain.SetScore{set(2, 0), set(2, 0), set(2, 0)}, nil},
+		{"too few sets", bestOf5, []domain.SetScore{set(2, 0)}, ErrTooFewSets},
+		{"set after clinch", bestOf5, []domain.SetScore{set(2, 0), set(2, 0), set(2, 0), set(0, 2)}, ErrSetAfterDecided},
+		{"tied set rejected", bestOf5, []domain.SetScore{set(1, 1), set(2, 0), set(2, 0), set(2, 0)}, ErrTiedSet},
+		{"tied set allowed", domain.MatchFormat{BestOf: 3, SetsToWin: 2, AllowTiedSets: true}, []domain.SetScore{set(1, 1), set(2, 0), set(0, 2)}, ErrSetsTied},
+		{"best of played out", domain.MatchFormat{BestOf: 3, SetsToWin: 2, AllowTiedSets: true}, []domain.SetScore{set(1, 1), set(1, 1), set(2, 0)}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := &domain.Match{Participant1ID: &p1, Participant2ID: &p2, Format: tt.format}
+			winnerID, err := computeWinnerFromSets(match, tt.sets)
+			if err != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && winnerID != p1 {
+				t.Errorf("expected participant 1 to win, got %d", winnerID)
+			}
+		})
+	}
+}
+
+func TestMatchFormat_Overrides(t *testing.T) {
+	settings := client.TournamentSettings{
+		MatchRules: &client.MatchRules{BestOf: 3},
+		MatchRuleOverrides: []client.MatchRuleOverride{
+			{BracketType: string(domain.BracketGrandFinal), MatchRules: client.MatchRules{BestOf: 5}},
+			{Round: 4, MatchRules: client.MatchRules{SetsToWin: 4, BestOf: 7}},
+		},
+	}
+
+	tests := []struct {
+		bracketType domain.BracketType
+		round       int
+		want        domain.MatchFormat
+	}{
+		{domain.BracketWinners, 1, domain.MatchFormat{BestOf: 3, SetsToWin: 2}},
+		{domain.BracketGrandFinal, 1, domain.MatchFormat{BestOf: 5, SetsToWin: 3}},
+		{domain.BracketWinners, 4, domain.MatchFormat{BestOf: 7, SetsToWin: 4}},
+	}
+
+	for _, tt := range tests {
+		if got := matchFormat(settings, tt.bracketType, tt.round); got != tt.want {
+			t.Errorf("%s round %d: expected %+v, got %+v", tt.bracketType, tt.round, tt.want, got)
+		}
+	}
+
+	if got := matchFormat(client.TournamentSettings{}, domain.BracketWinners, 1); got != (domain.MatchFormat{}) {
+		t.Errorf("expected no limits without match rules, got %+v", got)
+	}
+}
+
+func TestReportResult_DrawInRoundRobin(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	svc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateRoundRobin(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Level on sets is a draw
+	first := findMatch(t, repo, domain.BracketRoundRobin, 1, 1)
+	level := domain.MatchResult{Sets: []domain.SetScore{
+		{SetNumber: 1, Participant1Score: 2, Participant2Score: 0},
+		{SetNumber: 2, Participant1Score: 0, Participant2Score: 2},
+	}}
+	if err := svc.ReportResult(ctx, first.ID, level); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first = findMatch(t, repo, domain.BracketRoundRobin, 1, 1); !first.Draw || first.WinnerID != nil || first.Status != domain.MatchCompleted {
+		t.Errorf("expected a completed draw, got %+v", first)
+	}
+
+	// Win/draw/loss matches report only the outcome
+	second := findMatch(t, repo, domain.BracketRoundRobin, 1, 2)
+	repo.matches[second.ID].Format.Scoring = domain.ScoringWinDrawLoss
+	if err := svc.ReportResult(ctx, second.ID, p1Wins); err != ErrNoOutcome {
+		t.Errorf("expected ErrNoOutcome, got %v", err)
+	}
+	if err := svc.ReportResult(ctx, second.ID, domain.MatchResult{Outcome: domain.OutcomeDraw}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second = findMatch(t, repo, domain.BracketRoundRobin, 1, 2); !second.Draw {
+		t.Error("expected the outcome to be recorded as a draw")
+	}
+}
+
+func TestReportResult_DrawNotAllowedInElimination(t *testing.T) {
+	repo := newMockRepo()
+	createTestBracket(repo)
+	svc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	repo.matches[1].Format.Scoring = domain.ScoringWinDrawLoss
+	if err := svc.ReportResult(ctx, 1, domain.MatchResult{Outcome: domain.OutcomeDraw}); err != ErrDrawNotAllowed {
+		t.Errorf("expected ErrDrawNotAllowed, got %v", err)
+	}
+}
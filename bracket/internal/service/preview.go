@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+// FormatMultiStage generates the first stage of the tournament's configured stages.
+const FormatMultiStage = "multi_stage"
+
+var ErrUnsupportedFormat = errors.New("unsupported bracket format")
+
+// PreviewBracket generates a bracket exactly as it would be generated for real, byes
+// and all, but in memory: nothing is saved. Every match carries the projected path of
+// the seeds through the bracket (see engine.ProjectSeeds). Match IDs are placeholders
+// that only link the previewed matches to each other.
+func (s *bracketService) PreviewBracket(ctx context.Context, tournamentID uint64, format string, participants []domain.Participant) (*BracketState, error) {
+	repo := repository.NewMemoryMatchRepository()
+	slotRepo := repository.NewMemorySlotRepository()
+	dryRun := &bracketService{repo: repo, slotRepo: slotRepo, tournamentClient: s.tournamentClient}
+
+	var state *BracketState
+	var err error
+	switch format {
+	case string(engine.FormatSingleElim):
+		state, err = dryRun.GenerateSingleElimination(ctx, tournamentID, participants)
+	case string(engine.FormatDoubleElim):
+		state, err = dryRun.GenerateDoubleElimination(ctx, tournamentID, participants)
+	case string(engine.FormatRoundRobin):
+		state, err = dryRun.GenerateRoundRobin(ctx, tournamentID, participants)
+	case string(engine.FormatSwiss):
+		state, err = dryRun.GenerateSwiss(ctx, tournamentID, participants)
+	case FormatMultiStage:
+		state, err = dryRun.GenerateMultiStage(ctx, tournamentID, participants)
+	case string(engine.FormatFreeForAll):
+		// Generating heats uses neither stations nor the audit log
+		state, err = NewHeatService(repo, slotRepo, nil, nil, s.tournamentClient).GenerateFreeForAll(ctx, tournamentID, participants)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Heats are generated with their slots
+	for _, m := range state.Matches {
+		if m.Slots, err = slotRepo.GetByMatchID(ctx, m.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	state.Projections = engine.ProjectSeeds(state.Matches)
+	return state, nil
+}
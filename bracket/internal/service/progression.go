@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+// placeParticipant seats a participant from a finished match into the slot they
+// are routed to in a downstream match. If loser is true the participant is following
+// the LoserMatchID route (double elimination), otherwise the NextMatchID route.
+// The downstream match is marked ready once both slots are filled.
+func placeParticipant(ctx context.Context, repo repository.MatchRepository, from *domain.Match, participantID, targetID uint64, loser bool) error {
+	target, err := repo.GetByID(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	slot := engine.WinnerSlot(from, target)
+	if loser {
+		slot = engine.LoserSlot(from, target)
+	}
+
+	name, seed := participantInfo(from, participantID)
+	if err := repo.SetParticipant(ctx, target.ID, slot, participantID, name, seed); err != nil {
+		return err
+	}
+
+	// Refresh target to check if both participants are now set
+	target, err = repo.GetByID(ctx, target.ID)
+	if err != nil {
+		return err
+	}
+
+	// If both participants are set, mark as ready
+	if target.Participant1ID != nil && target.Participant2ID != nil {
+		if err := repo.UpdateStatus(ctx, target.ID, domain.MatchReady); err != nil {
+			return err
+		}
+
+		// Someone placed opposite a participant who was disqualified or withdrew
+		// while waiting here wins by forfeit. If both are out, nobody advances and
+		// the slot downstream is left for settleByes
+		if winnerID := forfeitingOpponent(target); winnerID != nil {
+			return forfeitTo(ctx, repo, target, *winnerID)
+		}
+		if forfeits(target, 1) && forfeits(target, 2) {
+			return repo.CompleteWithoutWinner(ctx, target.ID)
+		}
+	}
+
+	return nil
+}
+
+// forfeitingOpponent returns the participant facing one who forfeits a match (see
+// forfeits), or nil if neither (or both) of them forfeits.
+func forfeitingOpponent(match *domain.Match) *uint64 {
+	switch {
+	case forfeits(match, 1) && !forfeits(match, 2):
+		return match.Participant2ID
+	case forfeits(match, 2) && !forfeits(match, 1):
+		return match.Participant1ID
+	}
+	return nil
+}
+
+// forfeits reports whether the participant in the given slot (1 or 2) of a match
+// forfeits it: they were disqualified, or withdrew while waiting for an opponent.
+func forfeits(match *domain.Match, slot int) bool {
+	if slot == 1 {
+		return match.Participant1Disqualified || match.Participant1Withdrawn
+	}
+	return match.Participant2Disqualified || match.Participant2Withdrawn
+}
+
+// forfeitTo records a forfeit win and advances the winner. The forfeiting participant
+// is out of the tournament, so they are not dropped into a loser match; the slot they
+// would have taken is left for settleByes.
+func forfeitTo(ctx context.Context, repo repository.MatchRepository, match *domain.Match, winnerID uint64) error {
+	if err := repo.UpdateForfeit(ctx, match.ID, winnerID); err != nil {
+		return err
+	}
+	if match.NextMatchID != nil {
+		return placeParticipant(ctx, repo, match, winnerID, *match.NextMatchID, false)
+	}
+	return nil
+}
+
+// settleByes resolves matches that can never be played because one or both slots
+// will never be filled: round 1 byes, and later matches whose feeder finished
+// without sending anyone (e.g. a losers bracket slot fed by a bye). A lone participant
+// wins automatically and advances, unless they were disqualified or withdrew while
+// waiting; an empty match completes without a winner.
+// Winners of completed matches that have not been advanced yet (byes from generation)
+// are also placed. Repeats until nothing changes.
+func settleByes(ctx context.Context, repo repository.MatchRepository, tournamentID uint64) error {
+	for {
+		matches, err := repo.GetByTournament(ctx, tournamentID)
+		if err != nil {
+			return err
+		}
+
+		changed, err := settleByesPass(ctx, repo, matches)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+	}
+}
+
+// settleByesPass performs one pass of settleByes over a snapshot of the bracket.
+func settleByesPass(ctx context.Context, repo repository.MatchRepository, matches []*domain.Match) (bool, error) {
+	byID := make(map[uint64]*domain.Match, len(matches))
+	for _, m := range matches {
+		byID[m.ID] = m
+	}
+
+	// A slot can still be filled while any feeder is unfinished, or while a finished
+	// winner-route feeder has a winner that has not been placed yet. Losers are placed
+	// as soon as their match completes, so a finished loser-route feeder is settled.
+	live := make(map[uint64]map[int]bool)
+	markLive := func(target *domain.Match, slot int) {
+		if live[target.ID] == nil {
+			live[target.ID] = make(map[int]bool)
+		}
+		live[target.ID][slot] = true
+	}
+	for _, m := range matches {
+		if m.NextMatchID != nil {
+			if next, ok := byID[*m.NextMatchID]; ok && (m.Status != domain.MatchCompleted || m.WinnerID != nil) {
+				markLive(next, engine.WinnerSlot(m, next))
+			}
+		}
+		if m.LoserMatchID != nil {
+			if target, ok := byID[*m.LoserMatchID]; ok && m.Status != domain.MatchCompleted {
+				markLive(target, engine.LoserSlot(m, target))
+			}
+		}
+	}
+
+	// A slot is dead when it is empty and nothing can fill it any more
+	slotDead := func(m *domain.Match, slot int) bool {
+		return !live[m.ID][slot]
+	}
+
+	changed := false
+	for _, m := range matches {
+		// Heats fill their slots by placement, never by bye
+		if m.BracketType == domain.BracketHeat {
+			continue
+		}
+
+		if m.Status == domain.MatchCompleted {
+			// Advance winners that were decided before links existed (generation byes)
+			if m.WinnerID == nil || m.NextMatchID == nil {
+				continue
+			}
+			next, ok := byID[*m.NextMatchID]
+			if !ok || next.Status == domain.MatchCompleted {
+				continue
+			}
+			occupant := next.Participant1ID
+			if engine.WinnerSlot(m, next) == 2 {
+				occupant = next.Participant2ID
+			}
+			if occupant == nil {
+				if err := placeParticipant(ctx, repo, m, *m.WinnerID, next.ID, false); err != nil {
+					return false, err
+				}
+				changed = true
+			}
+			continue
+		}
+
+		p1Dead := m.Participant1ID == nil && slotDead(m, 1)
+		p2Dead := m.Participant2ID == nil && slotDead(m, 2)
+
+		switch {
+		case p1Dead && p2Dead,
+			p2Dead && forfeits(m, 1),
+			p1Dead && forfeits(m, 2):
+			if err := repo.CompleteWithoutWinner(ctx, m.ID); err != nil {
+				return false, err
+			}
+			changed = true
+		case p2Dead && m.Participant1ID != nil:
+			if err := completeBye(ctx, repo, m, *m.Participant1ID); err != nil {
+				return false, err
+			}
+			changed = true
+		case p1Dead && m.Participant2ID != nil:
+			if err := completeBye(ctx, repo, m, *m.Participant2ID); err != nil {
+				return false, err
+			}
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
+
+// completeBye awards a match to its only participant and advances them.
+func completeBye(ctx context.Context, repo repository.MatchRepository, match *domain.Match, winnerID uint64) error {
+	// For bye matches, just update the winner directly (no sets needed)
+	if err := repo.UpdateResult(ctx, match.ID, winnerID); err != nil {
+		return err
+	}
+	match.Status = domain.MatchCompleted
+	match.WinnerID = &winnerID
+
+	if match.NextMatchID != nil {
+		return placeParticipant(ctx, repo, match, winnerID, *match.NextMatchID, false)
+	}
+	return nil
+}
+
+// participantInfo returns the name and seed of a participant in a match.
+func participantInfo(match *domain.Match, participantID uint64) (string, int) {
+	name, seed := "", 0
+	if match.Participant1ID != nil && *match.Participant1ID == participantID {
+		if match.Participant1Name != nil {
+			name = *match.Participant1Name
+		}
+		if match.Seed1 != nil {
+			seed = *match.Seed1
+		}
+	} else if match.Participant2ID != nil && *match.Participant2ID == participantID {
+		if match.Participant2Name != nil {
+			name = *match.Participant2Name
+		}
+		if match.Seed2 != nil {
+			seed = *match.Seed2
+		}
+	}
+	return name, seed
+}
+
+// opponentOf returns the other participant in a match, or nil if there is none.
+func opponentOf(match *domain.Match, participantID uint64) *uint64 {
+	if match.Participant1ID != nil && *match.Participant1ID == participantID {
+		return match.Participant2ID
+	}
+	if match.Participant2ID != nil && *match.Participant2ID == participantID {
+		return match.Participant1ID
+	}
+	return nil
+}
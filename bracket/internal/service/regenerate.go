@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+var (
+	ErrBracketStarted      = errors.New("bracket already has results")
+	ErrInvalidParticipants = errors.New("participants must be active entrants of the tournament, each listed once")
+)
+
+// RegenerateBracket throws away the tournament's bracket and generates it again from
+// the given participants and seeds, e.g. to fix a seeding mistake. It is only allowed
+// while no match has been played: byes settled at generation do not count. The old
+// matches, their sets and the new matches are swapped in one transaction, which fails
+// if a match was completed after the check.
+func (s *bracketService) RegenerateBracket(ctx context.Context, tournamentID uint64, format string, participants []domain.Participant) (*BracketState, error) {
+	participants, err := verifyParticipants(ctx, s.tournamentClient, tournamentID, participants)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	var byes []uint64
+	for _, m := range existing {
+		if m.Status != domain.MatchCompleted {
+			continue
+		}
+		played, err := s.played(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if played {
+			return nil, ErrBracketStarted
+		}
+		byes = append(byes, m.ID)
+	}
+
+	// Build the new bracket in memory, byes and all, then save it in place of the old one
+	state, err := s.PreviewBracket(ctx, tournamentID, format, participants)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.ReplaceByTournament(ctx, tournamentID, byes, state.Matches)
+	if errors.Is(err, repository.ErrMatchStatusChanged) {
+		return nil, ErrBracketStarted
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state.Projections = nil
+	return state, nil
+}
+
+// verifyParticipants checks the given participants against the tournament service:
+// each must be an active entrant of the tournament, listed once. Only the seeds are
+// taken from the caller; names come from the tournament service. Without a tournament
+// client the participants are taken as given.
+func verifyParticipants(ctx context.Context, tournamentClient client.TournamentClient, tournamentID uint64, participants []domain.Participant) ([]domain.Participant, error) {
+	if tournamentClient == nil {
+		return participants, nil
+	}
+
+	verified := make([]domain.Participant, 0, len(participants))
+	seen := make(map[uint64]bool, len(participants))
+	for _, p := range participants {
+		if seen[p.ID] {
+			return nil, ErrInvalidParticipants
+		}
+		seen[p.ID] = true
+
+		participant, err := tournamentClient.GetParticipant(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load participant %d: %w", p.ID, err)
+		}
+		if participant.TournamentID != tournamentID || !isActive(participant.Status) {
+			return nil, ErrInvalidParticipants
+		}
+		p.Name = participant.DisplayName
+		verified = append(verified, p)
+	}
+	return verified, nil
+}
+
+// played reports whether a completed match was played rather than settled as a bye.
+// A heat is a bye when it seats no more participants than advance from it, so all of
+// them went through without racing.
+func (s *bracketService) played(ctx context.Context, m *domain.Match) (bool, error) {
+	if m.BracketType != domain.BracketHeat {
+		return hasResult(m), nil
+	}
+	if m.AdvanceCount == 0 {
+		return true, nil
+	}
+	slots, err := s.slotRepo.GetByMatchID(ctx, m.ID)
+	if err != nil {
+		return false, err
+	}
+	seated := 0
+	for _, slot := range slots {
+		if slot.ParticipantID != nil {
+			seated++
+		}
+	}
+	return seated > m.AdvanceCount, nil
+}
+
+// hasResult reports whether a match was decided by playing it (or by forfeit), as
+// opposed to a bye. Byes are matches completed with a participant missing.
+func hasResult(m *domain.Match) bool {
+	if m.Status != domain.MatchCompleted {
+		return false
+	}
+	if m.BracketType == domain.BracketHeat || m.ForfeitWinnerID != nil {
+		return true
+	}
+	return m.Participant1ID != nil && m.Participant2ID != nil
+}
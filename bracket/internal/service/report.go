@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+// DefaultReportConfirmWindow is how long a participant has to confirm or dispute their
+// opponent's report when the tournament does not set a window.
+const DefaultReportConfirmWindow = 15 * time.Minute
+
+var (
+	ErrNotReporter   = errors.New("only the tournament organizer and the match's participants can report its result")
+	ErrMatchDisputed = errors.New("match result is disputed and must be settled by the organizer")
+	ErrNoReport      = errors.New("opponent has not reported a result to confirm")
+)
+
+// ReportService handles results reported by the organizer or by the participants
+// themselves. The organizer's result is final. A participant's result waits for the
+// opponent to confirm it, or for the confirm window to pass; an opponent who reports a
+// different result disputes it instead.
+type ReportService interface {
+	ReportResult(ctx context.Context, matchID, userID uint64, result domain.MatchResult) (*domain.Match, error)
+	ConfirmResult(ctx context.Context, matchID, userID uint64) (*domain.Match, error)
+	ConfirmExpiredReports(ctx context.Context, now time.Time) error
+}
+
+type reportService struct {
+	repo             repository.MatchRepository
+	reportRepo       repository.ReportRepository
+	matchSvc         MatchService
+	tournamentClient client.TournamentClient
+}
+
+func NewReportService(
+	repo repository.MatchRepository,
+	reportRepo repository.ReportRepository,
+	matchSvc MatchService,
+	tournamentClient client.TournamentClient,
+) ReportService {
+	return &reportService{
+		repo:             repo,
+		reportRepo:       reportRepo,
+		matchSvc:         matchSvc,
+		tournamentClient: tournamentClient,
+	}
+}
+
+// ReportResult reports a match result for the given user. The organizer's result
+// completes the match straight away, settling any pending report or dispute. A
+// participant's result is held until the opponent confirms it. If the opponent already
+// reported, a matching result completes the match and a different one disputes it.
+func (s *reportService) ReportResult(ctx context.Context, matchID, userID uint64, result domain.MatchResult) (*domain.Match, error) {
+	match, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.BracketType == domain.BracketHeat {
+		return nil, ErrHeatMatch
+	}
+
+	tournament, err := s.tournamentClient.GetTournament(ctx, match.TournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tournament: %w", err)
+	}
+	if tournament.OrganizerID == userID {
+		return s.complete(ctx, matchID, result)
+	}
+
+	participantID, err := s.reporter(ctx, match, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := reportable(match); err != nil {
+		return nil, err
+	}
+	// Reject a result the match could never accept now, not once it is confirmed
+	if _, err := decideResult(match, result); err != nil {
+		return nil, err
+	}
+
+	window := DefaultReportConfirmWindow
+	if tournament.Settings.ReportConfirmMinutes > 0 {
+		window = time.Duration(tournament.Settings.ReportConfirmMinutes) * time.Minute
+	}
+	report := &domain.MatchReport{
+		MatchID:         matchID,
+		ParticipantID:   participantID,
+		UserID:          userID,
+		Result:          result,
+		ConfirmDeadline: time.Now().Add(window),
+	}
+
+	// Compare against the reports as saved with this one, not as read before it, so
+	// that of two participants reporting at once the second sees the first
+	reports, err := s.reportRepo.Save(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range reports {
+		if other.ParticipantID == participantID {
+			continue
+		}
+		if sameResult(other.Result, result) {
+			reason := fmt.Sprintf("reported by participants %d and %d", other.ParticipantID, participantID)
+			return s.complete(withReason(ctx, reason), matchID, result)
+		}
+
+		log.Printf("Match %d: result disputed by participant %d", matchID, participantID)
+		if err := s.repo.UpdateStatus(ctx, matchID, domain.MatchDisputed); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	return s.load(ctx, matchID)
+}
+
+// ConfirmResult confirms the result the user's opponent reported, completing the match.
+func (s *reportService) ConfirmResult(ctx context.Context, matchID, userID uint64) (*domain.Match, error) {
+	match, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	participantID, err := s.reporter(ctx, match, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := reportable(match); err != nil {
+		return nil, err
+	}
+
+	reports, err := s.reportRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range reports {
+		if other.ParticipantID != participantID {
+			reason := fmt.Sprintf("reported by participant %d and confirmed by participant %d", other.ParticipantID, participantID)
+			return s.complete(withReason(ctx, reason), matchID, other.Result)
+		}
+	}
+	return nil, ErrNoReport
+}
+
+// ConfirmExpiredReports lets every report whose confirm window closed by now stand, as
+// if the opponent had confirmed it. Reports on disputed matches wait for the organizer.
+// Reports left on a match that was settled some other way, e.g. by a forfeit, are
+// dropped. A report that cannot be confirmed is logged and retried next time.
+func (s *reportService) ConfirmExpiredReports(ctx context.Context, now time.Time) error {
+	reports, err := s.reportRepo.GetExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		match, err := s.repo.GetByID(ctx, report.MatchID)
+		if err != nil {
+			log.Printf("Failed to load match %d to confirm its report: %v", report.MatchID, err)
+			continue
+		}
+
+		switch match.Status {
+		case domain.MatchDisputed:
+			continue
+		case domain.MatchCompleted, domain.MatchPending:
+			err = s.reportRepo.DeleteByMatchID(ctx, match.ID)
+		default:
+			log.Printf("Match %d: report by participant %d confirmed after timeout", match.ID, report.ParticipantID)
+			reason := fmt.Sprintf("reported by participant %d and not confirmed in time", report.ParticipantID)
+			_, err = s.complete(withReason(ctx, reason), match.ID, report.Result)
+		}
+		if err != nil {
+			log.Printf("Failed to confirm report for match %d: %v", match.ID, err)
+		}
+	}
+	return nil
+}
+
+// RunReportTimer confirms reports whose confirm window closed every interval until ctx
+// is done.
+func RunReportTimer(ctx context.Context, reportSvc ReportService, interval time.Duration) {
+	runEvery(ctx, interval, func(now time.Time) {
+		if err := reportSvc.ConfirmExpiredReports(ctx, now); err != nil {
+			log.Printf("Failed to confirm expired reports: %v", err)
+		}
+	})
+}
+
+// complete records a final result and drops the match's pending reports.
+func (s *reportService) complete(ctx context.Context, matchID uint64, result domain.MatchResult) (*domain.Match, error) {
+	if err := s.matchSvc.ReportResult(ctx, matchID, result); err != nil {
+		return nil, err
+	}
+	if err := s.reportRepo.DeleteByMatchID(ctx, matchID); err != nil {
+		return nil, err
+	}
+	return s.load(ctx, matchID)
+}
+
+// load returns a match with its pending reports.
+func (s *reportService) load(ctx context.Context, matchID uint64) (*domain.Match, error) {
+	match, err := s.repo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	match.Reports, err = s.reportRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// reporter returns the participant of the match the user plays as.
+func (s *reportService) reporter(ctx context.Context, match *domain.Match, userID uint64) (uint64, error) {
+	participantID, ok, err := participantOfUser(ctx, s.tournamentClient, match, userID)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrNotReporter
+	}
+	return participantID, nil
+}
+
+// reportable checks that participants can still report a match's result.
+func reportable(match *domain.Match) error {
+	switch match.Status {
+	case domain.MatchCompleted:
+		return ErrMatchAlreadyComplete
+	case domain.MatchPending:
+		return ErrMatchNotReady
+	case domain.MatchDisputed:
+		return ErrMatchDisputed
+	}
+	return nil
+}
+
+// sameResult reports whether two reports agree on the sets and outcome.
+func sameResult(a, b domain.MatchResult) bool {
+	return a.Outcome == b.Outcome && slices.Equal(a.Sets, b.Sets)
+}
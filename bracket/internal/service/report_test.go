@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+// mockReportRepository implements repository.ReportRepository for testing
+type mockReportRepository struct {
+	reports []domain.MatchReport
+	nextID  uint64
+}
+
+func newMockReportRepo() *mockReportRepository {
+	return &mockReportRepository{nextID: 1}
+}
+
+func (r *mockReportRepository) Save(ctx context.Context, report *domain.MatchReport) ([]domain.MatchReport, error) {
+	saved := false
+	for i, existing := range r.reports {
+		if existing.MatchID == report.MatchID && existing.ParticipantID == report.ParticipantID {
+			report.ID = existing.ID
+			r.reports[i] = *report
+			saved = true
+		}
+	}
+	if !saved {
+		report.ID = r.nextID
+		r.nextID++
+		r.reports = append(r.reports, *report)
+	}
+	return r.GetByMatchID(ctx, report.MatchID)
+}
+
+func (r *mockReportRepository) GetByMatchID(ctx context.Context, matchID uint64) ([]domain.MatchReport, error) {
+	var reports []domain.MatchReport
+	for _, report := range r.reports {
+		if report.MatchID == matchID {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+func (r *mockReportRepository) GetExpired(ctx context.Context, now time.Time) ([]domain.MatchReport, error) {
+	var reports []domain.MatchReport
+	for _, report := range r.reports {
+		if !report.ConfirmDeadline.After(now) {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+func (r *mockReportRepository) DeleteByMatchID(ctx context.Context, matchID uint64) error {
+	kept := r.reports[:0]
+	for _, report := range r.reports {
+		if report.MatchID != matchID {
+			kept = append(kept, report)
+		}
+	}
+	r.reports = kept
+	return nil
+}
+
+const reportOrganizer = 1000
+
+// newTestReportService generates a 4 player single elimination bracket in which
+// participant N is played by user 100+N, and returns a report service for it.
+func newTestReportService(t *testing.T) (*mockMatchRepository, ReportService) {
+	t.Helper()
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{
+		organizerID: reportOrganizer,
+		userIDs:     map[uint64]uint64{1: 101, 2: 102, 3: 103, 4: 104},
+	}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+
+	if _, err := bracketSvc.GenerateSingleElimination(context.Background(), 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return repo, NewReportService(repo, newMockReportRepo(), matchSvc, tournaments)
+}
+
+func TestReportResult_OpponentConfirms(t *testing.T) {
+	repo, reportSvc := newTestReportService(t)
+	ctx := context.Background()
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	user1, user2 := 100+*semi.Participant1ID, 100+*semi.Participant2ID
+
+	// Players in the other semifinal cannot report this one
+	other := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if _, err := reportSvc.ReportResult(ctx, semi.ID, 100+*other.Participant1ID, p1Wins); err != ErrNotReporter {
+		t.Errorf("expected ErrNotReporter, got %v", err)
+	}
+
+	match, err := reportSvc.ReportResult(ctx, semi.ID, user1, p1Wins)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if match.Status == domain.MatchCompleted || len(match.Reports) != 1 {
+		t.Fatalf("expected the result to wait for confirmation, got %s with %d reports", match.Status, len(match.Reports))
+	}
+
+	// Reporters cannot confirm their own result
+	if _, err := reportSvc.ConfirmResult(ctx, semi.ID, user1); err != ErrNoReport {
+		t.Errorf("expected ErrNoReport, got %v", err)
+	}
+
+	match, err = reportSvc.ConfirmResult(ctx, semi.ID, user2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if match.Status != domain.MatchCompleted || *match.WinnerID != *semi.Participant1ID {
+		t.Errorf("expected participant %d to win once confirmed, got %s", *semi.Participant1ID, match.Status)
+	}
+	if len(match.Reports) != 0 {
+		t.Errorf("expected confirmed reports to be cleared, got %d", len(match.Reports))
+	}
+}
+
+func TestReportResult_DisputeSettledByOrganizer(t *testing.T) {
+	repo, reportSvc := newTestReportService(t)
+	ctx := context.Background()
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	user1, user2 := 100+*semi.Participant1ID, 100+*semi.Participant2ID
+
+	if _, err := reportSvc.ReportResult(ctx, semi.ID, user1, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	match, err := reportSvc.ReportResult(ctx, semi.ID, user2, p2Wins)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if match.Status != domain.MatchDisputed || len(match.Reports) != 2 {
+		t.Fatalf("expected a dispute with both reports, got %s with %d reports", match.Status, len(match.Reports))
+	}
+
+	// Players cannot settle a dispute, and it does not time out
+	if _, err := reportSvc.ReportResult(ctx, semi.ID, user1, p2Wins); err != ErrMatchDisputed {
+		t.Errorf("expected ErrMatchDisputed, got %v", err)
+	}
+	if err := reportSvc.ConfirmExpiredReports(ctx, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if semi = findMatch(t, repo, domain.BracketWinners, 1, 1); semi.Status != domain.MatchDisputed {
+		t.Fatalf("expected the match to stay disputed, got %s", semi.Status)
+	}
+
+	match, err = reportSvc.ReportResult(ctx, semi.ID, reportOrganizer, p2Wins)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if match.Status != domain.MatchCompleted || *match.WinnerID != *semi.Participant2ID {
+		t.Errorf("expected the organizer's result to stand, got %s", match.Status)
+	}
+	if len(match.Reports) != 0 {
+		t.Errorf("expected the disputed reports to be cleared, got %d", len(match.Reports))
+	}
+}
+
+func TestConfirmExpiredReports(t *testing.T) {
+	repo, reportSvc := newTestReportService(t)
+	ctx := context.Background()
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 1)
+
+	if _, err := reportSvc.ReportResult(ctx, semi.ID, 100+*semi.Participant2ID, p2Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := reportSvc.ConfirmExpiredReports(ctx, time.Now()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if semi = findMatch(t, repo, domain.BracketWinners, 1, 1); semi.Status == domain.MatchCompleted {
+		t.Fatal("expected the report to wait out its confirm window")
+	}
+
+	if err := reportSvc.ConfirmExpiredReports(ctx, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi = findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if semi.Status != domain.MatchCompleted || *semi.WinnerID != *semi.Participant2ID {
+		t.Errorf("expected the unconfirmed report to stand, got %s", semi.Status)
+	}
+	final := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if final.Participant1ID == nil || *final.Participant1ID != *semi.Participant2ID {
+		t.Errorf("expected participant %d to advance to the final", *semi.Participant2ID)
+	}
+}
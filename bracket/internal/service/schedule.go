@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+var ErrNotScheduled = errors.New("tournament needs a start time and schedule settings to be scheduled")
+
+// ScheduleMatches projects a start time for each of the tournament's unplayed matches
+// and saves it, returning every match. Matches are never scheduled in the past: once
+// the tournament has started, the schedule runs from now.
+func (s *matchService) ScheduleMatches(ctx context.Context, tournamentID uint64) ([]*domain.Match, error) {
+	if s.tournamentClient == nil {
+		return nil, ErrNotScheduled
+	}
+	tournament, err := s.tournamentClient.GetTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tournament: %w", err)
+	}
+	if tournament.StartsAt == nil || tournament.Settings.Schedule == nil {
+		return nil, ErrNotScheduled
+	}
+
+	if err := scheduleMatches(ctx, s.repo, tournament, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByTournament(ctx, tournamentID)
+}
+
+// reschedule recomputes a tournament's schedule after its matches changed, e.g. a result
+// came in earlier or later than expected. Tournaments without a start time or schedule
+// settings are left alone. The schedule is only a projection, so failures are logged
+// rather than failing the change that triggered them.
+func reschedule(ctx context.Context, repo repository.MatchRepository, tournamentClient client.TournamentClient, tournamentID uint64) {
+	if tournamentClient == nil {
+		return
+	}
+	tournament, err := tournamentClient.GetTournament(ctx, tournamentID)
+	if err != nil {
+		log.Printf("Failed to load tournament %d to reschedule it: %v", tournamentID, err)
+		return
+	}
+	if tournament.StartsAt == nil || tournament.Settings.Schedule == nil {
+		return
+	}
+
+	if err := scheduleMatches(ctx, repo, tournament, time.Now()); err != nil {
+		log.Printf("Failed to reschedule tournament %d: %v", tournamentID, err)
+	}
+}
+
+// scheduleMatches projects and saves a start time for each of the tournament's
+// unplayed matches, starting no earlier than now.
+func scheduleMatches(ctx context.Context, repo repository.MatchRepository, tournament *client.TournamentResponse, now time.Time) error {
+	matches, err := repo.GetByTournament(ctx, tournament.ID)
+	if err != nil {
+		return err
+	}
+
+	settings := tournament.Settings.Schedule
+	start := *tournament.StartsAt
+	if now.After(start) {
+		start = now
+	}
+	schedule := engine.Schedule(matches, engine.ScheduleOptions{
+		Start:    start,
+		Duration: matchDuration(settings),
+		Setups:   settings.Setups,
+		Rest:     time.Duration(settings.RestMinutes) * time.Minute,
+	})
+
+	return repo.UpdateSchedule(ctx, schedule)
+}
+
+// matchDuration returns how long a match is expected to take: the configured length
+// for its best of, the configured match length, or the engine default.
+func matchDuration(settings *client.ScheduleSettings) func(*domain.Match) time.Duration {
+	return func(m *domain.Match) time.Duration {
+		if minutes, ok := settings.BestOfMinutes[m.Format.BestOf]; ok && m.Format.BestOf > 0 {
+			return time.Duration(minutes) * time.Minute
+		}
+		if settings.MatchMinutes > 0 {
+			return time.Duration(settings.MatchMinutes) * time.Minute
+		}
+		return engine.DefaultMatchDuration
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestSchedule_RecomputedAfterResult(t *testing.T) {
+	repo := newMockRepo()
+	startsAt := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	tournaments := &mockTournamentClient{
+		settings: client.TournamentSettings{Schedule: &client.ScheduleSettings{MatchMinutes: 30, Setups: 1}},
+		startsAt: &startsAt,
+	}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// One setup: the semifinals are played one after the other
+	semi1 := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	semi2 := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	final := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	for _, tt := range []struct {
+		match  *domain.Match
+		offset time.Duration
+	}{{semi1, 0}, {semi2, 30 * time.Minute}, {final, time.Hour}} {
+		if want := startsAt.Add(tt.offset); tt.match.ScheduledAt == nil || !tt.match.ScheduledAt.Equal(want) {
+			t.Errorf("match %d: expected to be scheduled at %v, got %v", tt.match.ID, want, tt.match.ScheduledAt)
+		}
+	}
+
+	// Semifinal 1 is reported before the tournament even starts, freeing the setup
+	if err := matchSvc.ReportResult(ctx, semi1.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if semi2 = findMatch(t, repo, domain.BracketWinners, 1, 2); !semi2.ScheduledAt.Equal(startsAt) {
+		t.Errorf("expected semifinal 2 to move up to %v, got %v", startsAt, semi2.ScheduledAt)
+	}
+	if final = findMatch(t, repo, domain.BracketWinners, 2, 1); !final.ScheduledAt.Equal(startsAt.Add(30 * time.Minute)) {
+		t.Errorf("expected the final to move up to %v, got %v", startsAt.Add(30*time.Minute), final.ScheduledAt)
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/braccet/bracket/internal/domain"
+)
+
+var (
+	ErrDrawNotAllowed = errors.New("draws are only possible in round robin and swiss matches")
+	ErrSingleScore    = errors.New("score matches are reported as a single score")
+	ErrNoOutcome      = errors.New("win/draw/loss matches are reported as an outcome without sets")
+)
+
+// decideResult works out who won a reported result under the match's scoring mode,
+// returning nil for a draw. Set and score matches report sets (a score match exactly
+// one, holding the final score); win/draw/loss matches report only an outcome.
+// Draws are only possible in round robin and swiss matches, where nobody advances.
+func decideResult(match *domain.Match, result domain.MatchResult) (*uint64, error) {
+	if match.Format.Scoring == domain.ScoringWinDrawLoss {
+		if len(result.Sets) > 0 {
+			return nil, ErrNoOutcome
+		}
+		switch result.Outcome {
+		case domain.OutcomeParticipant1:
+			return match.Participant1ID, nil
+		case domain.OutcomeParticipant2:
+			return match.Participant2ID, nil
+		case domain.OutcomeDraw:
+			if !drawAllowed(match) {
+				return nil, ErrDrawNotAllowed
+			}
+			return nil, nil
+		}
+		return nil, ErrNoOutcome
+	}
+
+	if len(result.Sets) == 0 {
+		return nil, ErrNoSets
+	}
+	if match.Format.Scoring == domain.ScoringScore && len(result.Sets) != 1 {
+		return nil, ErrSingleScore
+	}
+
+	winnerID, err := computeWinnerFromSets(match, result.Sets)
+	if errors.Is(err, ErrSetsTied) && drawAllowed(match) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &winnerID, nil
+}
+
+// drawAllowed reports whether a match can end in a draw.
+func drawAllowed(match *domain.Match) bool {
+	return match.BracketType == domain.BracketRoundRobin || match.BracketType == domain.BracketSwiss
+}
+
+// sameWinner reports whether two results have the same winner (nil for no winner).
+func sameWinner(a, b *uint64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
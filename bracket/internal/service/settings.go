@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+)
+
+// loadSettings fetches a tournament's format settings. Without a tournament client
+// (e.g. in tests) every setting keeps its default.
+func loadSettings(ctx context.Context, tournamentClient client.TournamentClient, tournamentID uint64) (client.TournamentSettings, error) {
+	if tournamentClient == nil {
+		return client.TournamentSettings{}, nil
+	}
+
+	tournament, err := tournamentClient.GetTournament(ctx, tournamentID)
+	if err != nil {
+		return client.TournamentSettings{}, fmt.Errorf("failed to load tournament settings: %w", err)
+	}
+
+	return tournament.Settings, nil
+}
+
+// swissRounds returns the number of Swiss rounds to play: the configured count, or
+// enough rounds to leave a single undefeated player.
+func swissRounds(settings client.TournamentSettings, participantCount int) int {
+	if settings.SwissRounds > 0 {
+		return settings.SwissRounds
+	}
+	return engine.SwissRounds(participantCount)
+}
+
+// tiebreakers returns the configured standings tiebreaker order, or the format's default
+// order if none is configured. Unknown tiebreakers are skipped.
+func tiebreakers(settings client.TournamentSettings, swiss bool) []engine.Tiebreaker {
+	var order []engine.Tiebreaker
+	for _, name := range settings.Tiebreakers {
+		t := engine.Tiebreaker(name)
+		if !engine.ValidTiebreaker(t) {
+			log.Printf("Ignoring unknown tiebreaker %q", name)
+			continue
+		}
+		order = append(order, t)
+	}
+	if len(order) > 0 {
+		return order
+	}
+	if swiss {
+		return engine.DefaultSwissTiebreakers
+	}
+	return engine.DefaultTiebreakers
+}
+
+// separateTags reseeds an elimination bracket's participants to keep those sharing a
+// tag apart until the configured round, returning the pairs it could not separate.
+func separateTags(participants []domain.Participant, settings client.TournamentSettings) ([]domain.Participant, []engine.SeedingConflict) {
+	if settings.SeparateTagsUntil == 0 {
+		return participants, nil
+	}
+	return engine.SeparateTags(participants, settings.SeparateTagsUntil)
+}
+
+// heatSettings returns the configured heat size and advancing count, or the defaults.
+func heatSettings(settings client.TournamentSettings) (heatSize, advance int) {
+	heatSize, advance = engine.DefaultHeatSize, engine.DefaultHeatAdvance
+	if settings.HeatSize > 0 {
+		heatSize = settings.HeatSize
+	}
+	if settings.HeatAdvance > 0 {
+		advance = settings.HeatAdvance
+	}
+	return heatSize, advance
+}
+
+// matchFormat returns the format for a match in the given bracket and round: the
+// tournament's scoring mode, and the first match rule override that applies to it,
+// otherwise the tournament's match rules. Without rules the format places no limits
+// on sets. Sets to win defaults to a majority of best of.
+func matchFormat(settings client.TournamentSettings, bracketType domain.BracketType, round int) domain.MatchFormat {
+	rules := settings.MatchRules
+	for i, o := range settings.MatchRuleOverrides {
+		if (o.BracketType == "" || o.BracketType == string(bracketType)) && (o.Round == 0 || o.Round == round) {
+			rules = &settings.MatchRuleOverrides[i].MatchRules
+			break
+		}
+	}
+	format := domain.MatchFormat{Scoring: domain.ScoringMode(settings.ScoringMode)}
+	if rules == nil {
+		return format
+	}
+
+	format.BestOf = rules.BestOf
+	format.SetsToWin = rules.SetsToWin
+	format.AllowTiedSets = rules.AllowTiedSets
+	if format.SetsToWin == 0 && format.BestOf > 0 {
+		format.SetsToWin = format.BestOf/2 + 1
+	}
+	return format
+}
+
+// applyMatchFormats sets the format of generated matches from the tournament's match
+// rules. Heats are decided by placement and have no format.
+func applyMatchFormats(matches []*domain.Match, settings client.TournamentSettings) {
+	for _, m := range matches {
+		if m.BracketType == domain.BracketHeat {
+			continue
+		}
+		m.Format = matchFormat(settings, m.BracketType, m.Round)
+	}
+}
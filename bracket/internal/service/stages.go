@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+var (
+	ErrNoStages    = errors.New("tournament has no stages")
+	ErrStageLocked = errors.New("match belongs to a finished stage and the next stage has already started")
+)
+
+// loadStagedTournament fetches a multi-stage tournament with its stage list.
+func loadStagedTournament(ctx context.Context, tournamentClient client.TournamentClient, tournamentID uint64) (*client.TournamentResponse, error) {
+	if tournamentClient == nil {
+		return nil, ErrNoStages
+	}
+
+	tournament, err := tournamentClient.GetTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tournament stages: %w", err)
+	}
+	if len(tournament.Stages) == 0 {
+		return nil, ErrNoStages
+	}
+
+	return tournament, nil
+}
+
+// generateStage generates the matches for one stage of a multi-stage tournament, along
+// with the linker for its format (nil if its matches are not linked). Every stage but
+// the last is split into seed-balanced round robin pools.
+func generateStage(tournamentID uint64, stage client.StageResponse, last bool, participants []domain.Participant, settings client.TournamentSettings) ([]*domain.Match, func([]*domain.Match), error) {
+	var matches []*domain.Match
+	var link func([]*domain.Match)
+
+	if last {
+		var err error
+		matches, link, err = generateFormat(tournamentID, engine.Format(stage.Format), participants, settings)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		for i, pool := range engine.AssignPools(participants, stage.PoolCount) {
+			poolMatches, err := engine.RoundRobin(tournamentID, pool)
+			if err != nil {
+				return nil, nil, fmt.Errorf("pool %d: %w", i+1, err)
+			}
+			for _, m := range poolMatches {
+				m.Pool = i + 1
+			}
+			matches = append(matches, poolMatches...)
+		}
+	}
+
+	for _, m := range matches {
+		m.Stage = stage.StageNumber
+	}
+	return matches, link, nil
+}
+
+// generateFormat generates a single-format bracket and returns its linker (nil if its
+// matches are not linked).
+func generateFormat(tournamentID uint64, format engine.Format, participants []domain.Participant, settings client.TournamentSettings) ([]*domain.Match, func([]*domain.Match), error) {
+	switch format {
+	case engine.FormatSingleElim:
+		generate := engine.SingleElimination
+		if settings.BracketSizing == engine.SizingPlayIn {
+			generate = engine.SingleEliminationPlayIn
+		}
+		matches, err := generate(tournamentID, participants)
+		if err == nil && settings.ThirdPlaceMatch {
+			matches = engine.AddThirdPlaceMatch(tournamentID, matches)
+		}
+		if err == nil && settings.ConsolationBracket {
+			matches = engine.AddConsolationBracket(tournamentID, matches)
+		}
+		return matches, engine.LinkMatches, err
+	case engine.FormatDoubleElim:
+		matches, err := engine.DoubleElimination(tournamentID, participants)
+		return matches, engine.LinkDoubleElimination, err
+	case engine.FormatRoundRobin:
+		matches, err := engine.RoundRobin(tournamentID, participants)
+		return matches, nil, err
+	case engine.FormatSwiss:
+		matches, err := engine.SwissRound(tournamentID, participants, nil)
+		return matches, nil, err
+	}
+	return nil, nil, fmt.Errorf("unsupported stage format %q", format)
+}
+
+// startNextStage generates the next stage of a multi-stage tournament once every match
+// of the current pool stage is complete, seeding the qualifiers from the pool standings.
+// Tournaments that are not between stages are left alone.
+func startNextStage(ctx context.Context, repo repository.MatchRepository, setRepo repository.SetRepository, tournamentClient client.TournamentClient, tournamentID uint64) error {
+	matches, err := repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+
+	current := engine.CurrentStage(matches)
+	stageMatches := engine.StageMatches(matches, current)
+	if !engine.IsPoolStage(stageMatches) || !engine.AllCompleted(stageMatches) {
+		return nil
+	}
+
+	if tournamentClient == nil {
+		return nil
+	}
+	tournament, err := tournamentClient.GetTournament(ctx, tournamentID)
+	if err != nil {
+		return fmt.Errorf("failed to load tournament stages: %w", err)
+	}
+	if current >= len(tournament.Stages) {
+		return nil
+	}
+
+	if err := loadSets(ctx, setRepo, stageMatches); err != nil {
+		return err
+	}
+	order := tiebreakers(tournament.Settings, false)
+	pools := engine.PoolStandings(stageMatches, order)
+	qualifiers := engine.Qualifiers(pools, tournament.Stages[current-1].QualifiersPerPool, order)
+
+	next := tournament.Stages[current]
+	last := current+1 == len(tournament.Stages)
+	nextMatches, link, err := generateStage(tournamentID, next, last, qualifiers, tournament.Settings)
+	if err != nil {
+		return err
+	}
+
+	return saveMatches(ctx, repo, tournamentID, nextMatches, link, tournament.Settings)
+}
+
+// ensureStageOpen rejects changes to matches of a stage that has already been
+// followed by the next one: its results have been used to seed that stage.
+func ensureStageOpen(ctx context.Context, repo repository.MatchRepository, match *domain.Match) error {
+	matches, err := repo.GetByTournament(ctx, match.TournamentID)
+	if err != nil {
+		return err
+	}
+	if match.Stage < engine.CurrentStage(matches) {
+		return ErrStageLocked
+	}
+	return nil
+}
+
+// loadSets attaches each match's sets.
+func loadSets(ctx context.Context, setRepo repository.SetRepository, matches []*domain.Match) error {
+	matchIDs := make([]uint64, len(matches))
+	for i, m := range matches {
+		matchIDs[i] = m.ID
+	}
+
+	setsMap, err := setRepo.GetByMatchIDs(ctx, matchIDs)
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		m.Sets = setsMap[m.ID]
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+)
+
+var ErrNoStandings = errors.New("bracket has no round robin or swiss matches")
+
+// Standings is the ranked standings table for a tournament's round robin or Swiss
+// matches. Entries of a pool stage are grouped by pool and ranked within it.
+type Standings struct {
+	TournamentID uint64
+	Stage        int
+	Tiebreakers  []engine.Tiebreaker
+	Entries      []engine.Standing
+}
+
+// GetStandings computes the standings table for a tournament's round robin or Swiss
+// matches, using the tiebreaker order configured on the tournament.
+func (s *matchService) GetStandings(ctx context.Context, tournamentID uint64) (*Standings, error) {
+	matches, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := loadSettings(ctx, s.tournamentClient, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.standings(ctx, tournamentID, matches, settings)
+}
+
+// standings ranks the round robin and Swiss matches among matches, loading their sets
+// for the set based tiebreakers. In a multi-stage tournament only the latest such
+// stage is ranked, and pools are ranked separately.
+func (s *matchService) standings(ctx context.Context, tournamentID uint64, matches []*domain.Match, settings client.TournamentSettings) (*Standings, error) {
+	stage := 0
+	for _, m := range matches {
+		if isRanked(m) {
+			stage = max(stage, m.Stage)
+		}
+	}
+
+	var ranked []*domain.Match
+	swiss := false
+	for _, m := range matches {
+		if isRanked(m) && m.Stage == stage {
+			ranked = append(ranked, m)
+			swiss = swiss || m.BracketType == domain.BracketSwiss
+		}
+	}
+	if len(ranked) == 0 {
+		return nil, ErrNoStandings
+	}
+
+	if err := loadSets(ctx, s.setRepo, ranked); err != nil {
+		return nil, err
+	}
+
+	order := tiebreakers(settings, swiss)
+	var entries []engine.Standing
+	for _, pool := range engine.PoolStandings(ranked, order) {
+		entries = append(entries, pool...)
+	}
+
+	return &Standings{
+		TournamentID: tournamentID,
+		Stage:        stage,
+		Tiebreakers:  order,
+		Entries:      entries,
+	}, nil
+}
+
+// isRanked reports whether a match counts towards the standings.
+func isRanked(m *domain.Match) bool {
+	return m.BracketType == domain.BracketRoundRobin || m.BracketType == domain.BracketSwiss
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+var ErrStationTournament = errors.New("station belongs to another tournament")
+
+// StationQueue is where a tournament's matches are being played and what is waiting.
+type StationQueue struct {
+	TournamentID uint64
+	Stations     []*domain.Station
+	Matches      map[uint64]*domain.Match // Matches on a station, by match ID
+	Waiting      []*domain.Match          // Ready matches waiting for a station, in priority order
+}
+
+type StationService interface {
+	CreateStation(ctx context.Context, station *domain.Station) (*StationQueue, error)
+	UpdateStation(ctx context.Context, station *domain.Station) (*StationQueue, error)
+	DeleteStation(ctx context.Context, tournamentID, stationID uint64) (*StationQueue, error)
+	AssignStations(ctx context.Context, tournamentID uint64) (*StationQueue, error)
+	GetQueue(ctx context.Context, tournamentID uint64) (*StationQueue, error)
+}
+
+type stationService struct {
+	repo             repository.MatchRepository
+	stationRepo      repository.StationRepository
+	tournamentClient client.TournamentClient
+}
+
+func NewStationService(
+	repo repository.MatchRepository,
+	stationRepo repository.StationRepository,
+	tournamentClient client.TournamentClient,
+) StationService {
+	return &stationService{
+		repo:             repo,
+		stationRepo:      stationRepo,
+		tournamentClient: tournamentClient,
+	}
+}
+
+// CreateStation adds a station to a tournament and gives it a match if one is waiting.
+func (s *stationService) CreateStation(ctx context.Context, station *domain.Station) (*StationQueue, error) {
+	if err := s.stationRepo.Create(ctx, station); err != nil {
+		return nil, err
+	}
+	return s.AssignStations(ctx, station.TournamentID)
+}
+
+// UpdateStation renames a station or changes its stream and active flags. A station
+// that is deactivated keeps its current match but gets no new ones.
+func (s *stationService) UpdateStation(ctx context.Context, station *domain.Station) (*StationQueue, error) {
+	existing, err := s.stationRepo.GetByID(ctx, station.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.TournamentID != station.TournamentID {
+		return nil, ErrStationTournament
+	}
+
+	if err := s.stationRepo.Update(ctx, station); err != nil {
+		return nil, err
+	}
+	return s.AssignStations(ctx, station.TournamentID)
+}
+
+// DeleteStation removes a station. Its match goes back to the front of the queue.
+func (s *stationService) DeleteStation(ctx context.Context, tournamentID, stationID uint64) (*StationQueue, error) {
+	existing, err := s.stationRepo.GetByID(ctx, stationID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.TournamentID != tournamentID {
+		return nil, ErrStationTournament
+	}
+
+	if err := s.stationRepo.Delete(ctx, stationID); err != nil {
+		return nil, err
+	}
+	return s.AssignStations(ctx, tournamentID)
+}
+
+// AssignStations puts ready matches on the tournament's free stations.
+func (s *stationService) AssignStations(ctx context.Context, tournamentID uint64) (*StationQueue, error) {
+	return assignStations(ctx, s.repo, s.stationRepo, s.tournamentClient, tournamentID)
+}
+
+// GetQueue returns the tournament's stations with their matches and the matches
+// waiting for one, without assigning anything.
+func (s *stationService) GetQueue(ctx context.Context, tournamentID uint64) (*StationQueue, error) {
+	stations, err := s.stationRepo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	return stationQueue(tournamentID, stations, matches), nil
+}
+
+// assignStations puts ready matches on the tournament's free stations (see
+// engine.AssignStations) and returns the resulting queue. Stations still holding a
+// match that is over, or no longer ready to be played, are freed first.
+func assignStations(ctx context.Context, repo repository.MatchRepository, stationRepo repository.StationRepository, tournamentClient client.TournamentClient, tournamentID uint64) (*StationQueue, error) {
+	stations, err := stationRepo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(stations) == 0 {
+		return stationQueue(tournamentID, stations, matches), nil
+	}
+
+	settings, err := loadSettings(ctx, tournamentClient, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	streamSeeds := engine.DefaultStreamSeeds
+	if settings.StreamSeeds > 0 {
+		streamSeeds = settings.StreamSeeds
+	}
+
+	byID := make(map[uint64]*domain.Match, len(matches))
+	for _, m := range matches {
+		byID[m.ID] = m
+	}
+
+	var playing []*domain.Match
+	for _, st := range stations {
+		if st.MatchID == nil {
+			continue
+		}
+		m, ok := byID[*st.MatchID]
+		if ok && (m.Status == domain.MatchReady || m.Status == domain.MatchCalled || m.Status == domain.MatchInProgress) {
+			playing = append(playing, m)
+			continue
+		}
+		if err := stationRepo.Release(ctx, *st.MatchID); err != nil {
+			return nil, err
+		}
+		st.MatchID = nil
+	}
+	for _, m := range matches {
+		if m.Status == domain.MatchCalled || m.Status == domain.MatchInProgress {
+			playing = append(playing, m)
+		}
+	}
+
+	queue := engine.StationQueue(matches, stations)
+	assignments := engine.AssignStations(stations, queue, playing, streamSeeds)
+	for _, st := range stations {
+		matchID, ok := assignments[st.ID]
+		if !ok {
+			continue
+		}
+		if err := stationRepo.Assign(ctx, st.ID, matchID); err != nil {
+			return nil, err
+		}
+		st.MatchID = &matchID
+	}
+
+	return stationQueue(tournamentID, stations, matches), nil
+}
+
+// releaseStation frees the station a completed match was played on and hands it, and
+// any other free station, to the next matches in the queue.
+func releaseStation(ctx context.Context, repo repository.MatchRepository, stationRepo repository.StationRepository, tournamentClient client.TournamentClient, match *domain.Match) {
+	if stationRepo == nil {
+		return
+	}
+	if err := stationRepo.Release(ctx, match.ID); err != nil {
+		log.Printf("Failed to release the station of match %d: %v", match.ID, err)
+		return
+	}
+	reassignStations(ctx, repo, stationRepo, tournamentClient, match.TournamentID)
+}
+
+// reassignStations frees the stations of a tournament's matches that are over and hands
+// them to the next matches in the queue. Stations only direct where matches are played,
+// so failures are logged rather than failing the result that freed them; the queue is
+// brought up to date by the next assignment.
+func reassignStations(ctx context.Context, repo repository.MatchRepository, stationRepo repository.StationRepository, tournamentClient client.TournamentClient, tournamentID uint64) {
+	if stationRepo == nil {
+		return
+	}
+	if _, err := assignStations(ctx, repo, stationRepo, tournamentClient, tournamentID); err != nil {
+		log.Printf("Failed to reassign stations of tournament %d: %v", tournamentID, err)
+	}
+}
+
+func stationQueue(tournamentID uint64, stations []*domain.Station, matches []*domain.Match) *StationQueue {
+	byID := make(map[uint64]*domain.Match, len(matches))
+	for _, m := range matches {
+		byID[m.ID] = m
+	}
+
+	queue := &StationQueue{
+		TournamentID: tournamentID,
+		Stations:     stations,
+		Matches:      make(map[uint64]*domain.Match),
+		Waiting:      engine.StationQueue(matches, stations),
+	}
+	for _, st := range stations {
+		if st.MatchID == nil {
+			continue
+		}
+		if m, ok := byID[*st.MatchID]; ok {
+			queue.Matches[m.ID] = m
+		}
+	}
+	return queue
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/repository"
+)
+
+// mockStationRepository implements repository.StationRepository for testing
+type mockStationRepository struct {
+	stations map[uint64]*domain.Station
+	nextID   uint64
+}
+
+func newMockStationRepo() *mockStationRepository {
+	return &mockStationRepository{stations: make(map[uint64]*domain.Station), nextID: 1}
+}
+
+func (r *mockStationRepository) Create(ctx context.Context, station *domain.Station) error {
+	station.ID = r.nextID
+	r.nextID++
+	stored := *station
+	r.stations[station.ID] = &stored
+	return nil
+}
+
+func (r *mockStationRepository) GetByID(ctx context.Context, id uint64) (*domain.Station, error) {
+	s, ok := r.stations[id]
+	if !ok {
+		return nil, repository.ErrStationNotFound
+	}
+	copied := *s
+	return &copied, nil
+}
+
+func (r *mockStationRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Station, error) {
+	var stations []*domain.Station
+	for id := uint64(1); id < r.nextID; id++ {
+		if s, ok := r.stations[id]; ok && s.TournamentID == tournamentID {
+			copied := *s
+			stations = append(stations, &copied)
+		}
+	}
+	return stations, nil
+}
+
+func (r *mockStationRepository) Update(ctx context.Context, station *domain.Station) error {
+	s, ok := r.stations[station.ID]
+	if !ok {
+		return repository.ErrStationNotFound
+	}
+	s.Name = station.Name
+	s.Stream = station.Stream
+	s.Active = station.Active
+	return nil
+}
+
+func (r *mockStationRepository) Delete(ctx context.Context, id uint64) error {
+	if _, ok := r.stations[id]; !ok {
+		return repository.ErrStationNotFound
+	}
+	delete(r.stations, id)
+	return nil
+}
+
+func (r *mockStationRepository) Assign(ctx context.Context, stationID, matchID uint64) error {
+	s, ok := r.stations[stationID]
+	if !ok {
+		return repository.ErrStationNotFound
+	}
+	s.MatchID = &matchID
+	return nil
+}
+
+func (r *mockStationRepository) Release(ctx context.Context, matchID uint64) error {
+	for _, s := range r.stations {
+		if s.MatchID != nil && *s.MatchID == matchID {
+			s.MatchID = nil
+		}
+	}
+	return nil
+}
+
+// stationMatch returns the ID of the match on the named station, or 0 if it is free.
+func stationMatch(t *testing.T, stationRepo *mockStationRepository, name string) uint64 {
+	t.Helper()
+	for _, s := range stationRepo.stations {
+		if s.Name != name {
+			continue
+		}
+		if s.MatchID == nil {
+			return 0
+		}
+		return *s.MatchID
+	}
+	t.Fatalf("no station named %s", name)
+	return 0
+}
+
+func TestStations_AssignedInQueueOrderWithStreamPreference(t *testing.T) {
+	repo := newMockRepo()
+	stationRepo := newMockStationRepo()
+	tournaments := &mockTournamentClient{settings: client.TournamentSettings{StreamSeeds: 2}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), stationRepo, newMockEventRepo(), tournaments, nil)
+	stationSvc := NewStationService(repo, stationRepo, tournaments)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []domain.Station{
+		{TournamentID: 1, Name: "Setup 1", Active: true},
+		{TournamentID: 1, Name: "Setup 2", Active: true},
+		{TournamentID: 1, Name: "Setup 3"},
+		{TournamentID: 1, Name: "Stream", Stream: true, Active: true},
+	} {
+		stationRepo.Create(ctx, &s)
+	}
+
+	queue, err := stationSvc.AssignStations(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Seeds 1 and 2 are featured: the first of their matches in the queue gets the
+	// stream, and the regular setups take the rest in order
+	var featured uint64
+	for position := 1; position <= 4 && featured == 0; position++ {
+		m := findMatch(t, repo, domain.BracketWinners, 1, position)
+		if *m.Seed1 <= 2 || *m.Seed2 <= 2 {
+			featured = m.ID
+		}
+	}
+	if got := stationMatch(t, stationRepo, "Stream"); got != featured {
+		t.Errorf("expected the first featured match %d on stream, got %d", featured, got)
+	}
+	if got := stationMatch(t, stationRepo, "Setup 3"); got != 0 {
+		t.Errorf("expected the inactive station to stay free, got match %d", got)
+	}
+	if len(queue.Waiting) != 1 {
+		t.Fatalf("expected 1 match waiting for a station, got %d", len(queue.Waiting))
+	}
+
+	// Reporting a result frees its station for the match waiting in the queue
+	setup1 := stationMatch(t, stationRepo, "Setup 1")
+	if err := matchSvc.ReportResult(ctx, setup1, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := stationMatch(t, stationRepo, "Setup 1"); got != queue.Waiting[0].ID {
+		t.Errorf("expected the waiting match %d on Setup 1, got %d", queue.Waiting[0].ID, got)
+	}
+}
+
+func TestStations_FreedByForfeitsAndHeats(t *testing.T) {
+	ctx := context.Background()
+
+	// A double forfeit frees its station like a reported result
+	repo := newMockRepo()
+	stationRepo := newMockStationRepo()
+	tournaments := &mockTournamentClient{}
+	forfeitSvc := NewForfeitService(repo, newMockSetRepo(), stationRepo, newMockEventRepo(), tournaments)
+	stationSvc := NewStationService(repo, stationRepo, tournaments)
+
+	if _, err := NewBracketService(repo, newMockSlotRepo(), tournaments).GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stationRepo.Create(ctx, &domain.Station{TournamentID: 1, Name: "Setup 1", Active: true})
+	if _, err := stationSvc.AssignStations(ctx, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first := stationMatch(t, stationRepo, "Setup 1")
+	if _, err := forfeitSvc.DoubleForfeit(ctx, first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := stationMatch(t, stationRepo, "Setup 1"); got == 0 || got == first {
+		t.Errorf("expected the forfeited match's station to take the next match, got %d", got)
+	}
+
+	// So does a heat once its placements are reported
+	repo = newMockRepo()
+	stationRepo = newMockStationRepo()
+	heatSvc := NewHeatService(repo, newMockSlotRepo(), stationRepo, newMockEventRepo(), tournaments)
+	stationSvc = NewStationService(repo, stationRepo, tournaments)
+
+	if _, err := heatSvc.GenerateFreeForAll(ctx, 1, makeParticipants(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stationRepo.Create(ctx, &domain.Station{TournamentID: 1, Name: "Setup 1", Active: true})
+	if _, err := stationSvc.AssignStations(ctx, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	heat1 := findMatch(t, repo, domain.BracketHeat, 1, 1)
+	heat2 := findMatch(t, repo, domain.BracketHeat, 1, 2)
+	if got := stationMatch(t, stationRepo, "Setup 1"); got != heat1.ID {
+		t.Fatalf("expected heat 1 on the station, got %d", got)
+	}
+	if err := heatSvc.ReportPlacements(ctx, heat1.ID, placements(5, 1, 8, 4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := stationMatch(t, stationRepo, "Setup 1"); got != heat2.ID {
+		t.Errorf("expected heat 2 to take the station, got %d", got)
+	}
+}
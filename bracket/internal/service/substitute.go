@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/braccet/bracket/internal/domain"
+	"github.com/braccet/bracket/internal/engine"
+)
+
+var (
+	ErrMatchInProgress   = errors.New("participant has a match in progress")
+	ErrSubstituteInStage = errors.New("participants cannot be substituted in a round robin or Swiss stage")
+)
+
+// SubstituteParticipant hands a participant's place in the bracket to a substitute,
+// who takes over every one of their pending and ready matches, so the opponents still
+// have someone to play. Completed matches keep the original participant. It fails if
+// the participant is in the middle of a match, or of one whose result is disputed. A
+// substitute taking over a called match still has to check in. In heats the substitute
+// takes over the participant's slot.
+//
+// Round robin and Swiss stages rank on the whole stage's results, which the substitute
+// would not inherit, so substitution fails there with ErrSubstituteInStage.
+func (s *matchService) SubstituteParticipant(ctx context.Context, tournamentID, participantID uint64, substitute domain.Participant) ([]*domain.Match, error) {
+	all, err := s.repo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	stage := engine.StageMatches(all, engine.CurrentStage(all))
+	if engine.IsRoundRobin(stage) || engine.IsSwiss(stage) {
+		return nil, ErrSubstituteInStage
+	}
+
+	matches, err := s.repo.GetPendingByParticipant(ctx, tournamentID, participantID)
+	if err != nil {
+		return nil, err
+	}
+	heats, err := s.pendingHeats(ctx, all, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range append(matches, heats...) {
+		if m.Status == domain.MatchInProgress || m.Status == domain.MatchDisputed {
+			return nil, ErrMatchInProgress
+		}
+	}
+
+	substituted := make([]*domain.Match, 0, len(matches)+len(heats))
+	for _, m := range matches {
+		slot := 2
+		if m.Participant1ID != nil && *m.Participant1ID == participantID {
+			slot = 1
+		}
+		if err := s.repo.SetParticipant(ctx, m.ID, slot, substitute.ID, substitute.Name, substitute.Seed); err != nil {
+			return nil, err
+		}
+
+		updated, err := s.repo.GetByID(ctx, m.ID)
+		if err != nil {
+			return nil, err
+		}
+		substituted = append(substituted, updated)
+	}
+
+	for _, heat := range heats {
+		for _, slot := range heat.Slots {
+			if slot.ParticipantID == nil || *slot.ParticipantID != participantID {
+				continue
+			}
+			if err := s.slotRepo.SetParticipant(ctx, heat.ID, slot.SlotNumber, substitute.ID, substitute.Name, substitute.Seed); err != nil {
+				return nil, err
+			}
+		}
+		if heat.Slots, err = s.slotRepo.GetByMatchID(ctx, heat.ID); err != nil {
+			return nil, err
+		}
+		substituted = append(substituted, heat)
+	}
+
+	return substituted, nil
+}
+
+// pendingHeats returns the unfinished heats among the tournament's matches in which the
+// participant has a slot, with their slots.
+func (s *matchService) pendingHeats(ctx context.Context, matches []*domain.Match, participantID uint64) ([]*domain.Match, error) {
+	var ids []uint64
+	for _, m := range matches {
+		if m.BracketType == domain.BracketHeat && m.Status != domain.MatchCompleted {
+			ids = append(ids, m.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	slots, err := s.slotRepo.GetByMatchIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	var heats []*domain.Match
+	for _, m := range matches {
+		for _, slot := range slots[m.ID] {
+			if slot.ParticipantID != nil && *slot.ParticipantID == participantID {
+				m.Slots = slots[m.ID]
+				heats = append(heats, m)
+				break
+			}
+		}
+	}
+	return heats, nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/braccet/bracket/internal/client"
+	"github.com/braccet/bracket/internal/domain"
+)
+
+func TestSubstituteParticipant(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestMatchService(repo)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Seed 1 wins their first match, then drops out
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if err := matchSvc.ReportResult(ctx, semi.ID, p1Wins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sub := domain.Participant{ID: 10, Name: "Sub", Seed: 1}
+	matches, err := matchSvc.SubstituteParticipant(ctx, 1, 1, sub)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 match to be handed over, got %d", len(matches))
+	}
+
+	final := findMatch(t, repo, domain.BracketWinners, 2, 1)
+	if !isParticipant(final, 10) || isParticipant(final, 1) {
+		t.Error("expected the substitute to take seed 1's place in the final")
+	}
+	if semi = findMatch(t, repo, domain.BracketWinners, 1, 1); !isParticipant(semi, 1) {
+		t.Error("expected the completed match to keep the original participant")
+	}
+
+	// Seed 2 is mid-match and cannot be substituted
+	other := findMatch(t, repo, domain.BracketWinners, 1, 2)
+	if err := matchSvc.StartMatch(ctx, other.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := matchSvc.SubstituteParticipant(ctx, 1, 2, domain.Participant{ID: 11, Name: "Sub 2", Seed: 2}); !errors.Is(err, ErrMatchInProgress) {
+		t.Errorf("expected ErrMatchInProgress, got %v", err)
+	}
+}
+
+func TestSubstituteParticipant_CalledMatchResetsCheckIn(t *testing.T) {
+	repo := newMockRepo()
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), nil)
+	matchSvc := newTestCheckInService(repo)
+	ctx := context.Background()
+
+	if _, err := bracketSvc.GenerateSingleElimination(ctx, 1, makeParticipants(4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi := findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if _, err := matchSvc.CallMatch(ctx, semi.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := matchSvc.CheckIn(ctx, semi.ID, 100+*semi.Participant1ID, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sub := domain.Participant{ID: 10, Name: "Sub", Seed: 1}
+	if _, err := matchSvc.SubstituteParticipant(ctx, 1, *semi.Participant1ID, sub); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	semi = findMatch(t, repo, domain.BracketWinners, 1, 1)
+	if !isParticipant(semi, 10) || semi.Participant1CheckedIn {
+		t.Error("expected the substitute to take the slot without the replaced participant's check-in")
+	}
+}
+
+func TestSubstituteParticipant_Heats(t *testing.T) {
+	repo := newMockRepo()
+	slotRepo := newMockSlotRepo()
+	heatSvc := NewHeatService(repo, slotRepo, newMockStationRepo(), newMockEventRepo(), nil)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), slotRepo, newMockStationRepo(), newMockEventRepo(), nil, nil)
+	ctx := context.Background()
+
+	// Heat 1 has seeds 1, 4, 5 and 8
+	if _, err := heatSvc.GenerateFreeForAll(ctx, 1, makeParticipants(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	heat := findMatch(t, repo, domain.BracketHeat, 1, 1)
+
+	sub := domain.Participant{ID: 10, Name: "Sub", Seed: 1}
+	matches, err := matchSvc.SubstituteParticipant(ctx, 1, 1, sub)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 1 || matches[0].ID != heat.ID {
+		t.Fatalf("expected heat 1 to be handed over, got %d matches", len(matches))
+	}
+	seated := make(map[uint64]bool)
+	for _, slot := range slotRepo.slots[heat.ID] {
+		seated[*slot.ParticipantID] = true
+	}
+	if !seated[10] || seated[1] {
+		t.Error("expected the substitute to take seed 1's slot")
+	}
+
+	// The heat is being raced, so nobody in it can be substituted
+	if err := matchSvc.StartMatch(ctx, heat.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := matchSvc.SubstituteParticipant(ctx, 1, 4, domain.Participant{ID: 11, Name: "Sub 2", Seed: 4}); !errors.Is(err, ErrMatchInProgress) {
+		t.Errorf("expected ErrMatchInProgress, got %v", err)
+	}
+}
+
+func TestSubstituteParticipant_SwissStage(t *testing.T) {
+	repo := newMockRepo()
+	tournaments := &mockTournamentClient{statuses: map[uint64]string{}}
+	bracketSvc := NewBracketService(repo, newMockSlotRepo(), tournaments)
+	matchSvc := NewMatchService(repo, newMockSetRepo(), newMockSlotRepo(), newMockStationRepo(), newMockEventRepo(), tournaments, nil)
+	ctx := context.Background()
+
+	state, err := bracketSvc.GenerateSwiss(ctx, 1, makeParticipants(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, m := range state.Matches {
+		if err := matchSvc.ReportResult(ctx, m.ID, p1Wins); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// The substitute would not inherit seed 1's record, so the swap is refused
+	sub := domain.Participant{ID: 10, Name: "Sub", Seed: 1}
+	if _, err := matchSvc.SubstituteParticipant(ctx, 1, 1, sub); !errors.Is(err, ErrSubstituteInStage) {
+		t.Fatalf("expected ErrSubstituteInStage, got %v", err)
+	}
+
+	// A participant marked substituted is not paired again
+	tournaments.statuses[4] = client.ParticipantSubstituted
+	state, err = bracketSvc.NextSwissRound(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	paired := false
+	for _, m := range state.Matches {
+		if m.Round != 2 {
+			continue
+		}
+		if isParticipant(m, 10) || isParticipant(m, 4) {
+			t.Error("expected neither the substitute nor the substituted participant to be paired")
+		}
+		paired = paired || isParticipant(m, 1)
+	}
+	if !paired {
+		t.Error("expected seed 1 to keep playing with their record")
+	}
+}
@@ -0,0 +1,4 @@
+-- Add 'round_robin' to bracket_type enum
+-- Round robin matches have no next match; results only feed the standings
+
+ALTER TYPE bracket_type ADD VALUE 'round_robin';
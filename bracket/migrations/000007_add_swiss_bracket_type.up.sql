@@ -0,0 +1,4 @@
+-- Add 'swiss' to bracket_type enum
+-- Swiss rounds are paired one at a time from the results of earlier rounds
+
+ALTER TYPE bracket_type ADD VALUE 'swiss';
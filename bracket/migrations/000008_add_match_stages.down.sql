@@ -0,0 +1,6 @@
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_tournament_stage_pool_round_position_key;
+ALTER TABLE matches ADD CONSTRAINT matches_tournament_id_bracket_type_round_position_key
+    UNIQUE (tournament_id, bracket_type, round, position);
+
+ALTER TABLE matches DROP COLUMN IF EXISTS pool;
+ALTER TABLE matches DROP COLUMN IF EXISTS stage;
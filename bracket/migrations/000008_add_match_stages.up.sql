@@ -0,0 +1,9 @@
+-- Multi-stage tournaments: each match belongs to a stage (1 for single-stage
+-- tournaments) and optionally a pool within that stage (0 = no pool)
+ALTER TABLE matches ADD COLUMN stage INT NOT NULL DEFAULT 1;
+ALTER TABLE matches ADD COLUMN pool INT NOT NULL DEFAULT 0;
+
+-- Round/position are only unique within a stage and pool
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_tournament_id_bracket_type_round_position_key;
+ALTER TABLE matches ADD CONSTRAINT matches_tournament_stage_pool_round_position_key
+    UNIQUE (tournament_id, stage, pool, bracket_type, round, position);
@@ -0,0 +1,4 @@
+-- Add 'third_place' to bracket_type enum
+-- The optional third-place match of a single elimination bracket is played by the semifinal losers
+
+ALTER TYPE bracket_type ADD VALUE 'third_place';
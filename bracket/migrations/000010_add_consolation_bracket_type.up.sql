@@ -0,0 +1,4 @@
+-- Add 'consolation' to bracket_type enum
+-- The optional consolation bracket of a single elimination bracket is played by the first round losers
+
+ALTER TYPE bracket_type ADD VALUE 'consolation';
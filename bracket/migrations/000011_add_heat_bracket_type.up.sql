@@ -0,0 +1,4 @@
+-- Add 'heat' to bracket_type enum
+-- Free-for-all heats seat more than two participants, who are ranked by placement
+
+ALTER TYPE bracket_type ADD VALUE 'heat';
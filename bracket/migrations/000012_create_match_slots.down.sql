@@ -0,0 +1,5 @@
+-- Remove heat advancement count from matches
+ALTER TABLE matches DROP COLUMN IF EXISTS advance_count;
+
+-- Drop match_slots table
+DROP TABLE IF EXISTS match_slots;
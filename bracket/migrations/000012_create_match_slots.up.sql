@@ -0,0 +1,31 @@
+-- Add match_slots table for free-for-all heats
+-- A heat has one slot per participant instead of participant1/participant2, and
+-- results are reported as each participant's placement
+
+CREATE TABLE match_slots (
+    id BIGSERIAL PRIMARY KEY,
+    match_id BIGINT NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    slot_number INT NOT NULL,
+    participant_id BIGINT,
+    participant_name VARCHAR(100),
+    seed INT,
+    placement INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (match_id, slot_number),
+    CHECK (slot_number >= 1),
+    CHECK (placement IS NULL OR placement >= 1)
+);
+
+CREATE INDEX idx_match_slots_match_id ON match_slots(match_id);
+
+-- Reuse existing trigger function for updated_at
+CREATE TRIGGER update_match_slots_updated_at
+    BEFORE UPDATE ON match_slots
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Number of top finishers in a heat that advance to the next heat (0 for the final and
+-- for head-to-head matches)
+ALTER TABLE matches ADD COLUMN advance_count INT NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Remove match format columns
+ALTER TABLE matches DROP COLUMN IF EXISTS allow_tied_sets;
+ALTER TABLE matches DROP COLUMN IF EXISTS sets_to_win;
+ALTER TABLE matches DROP COLUMN IF EXISTS best_of;
@@ -0,0 +1,6 @@
+-- Set format each match is played under, resolved from the tournament's match rules
+-- when the match is generated. Zero means no limit, so existing matches keep
+-- accepting any number of sets
+ALTER TABLE matches ADD COLUMN best_of INT NOT NULL DEFAULT 0;
+ALTER TABLE matches ADD COLUMN sets_to_win INT NOT NULL DEFAULT 0;
+ALTER TABLE matches ADD COLUMN allow_tied_sets BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+-- Remove scoring mode and draw columns
+ALTER TABLE matches DROP COLUMN IF EXISTS draw;
+ALTER TABLE matches DROP COLUMN IF EXISTS scoring_mode;
@@ -0,0 +1,6 @@
+-- How each match's result is reported (sets, score or win_draw_loss), resolved from
+-- the tournament's scoring mode when the match is generated
+ALTER TABLE matches ADD COLUMN scoring_mode VARCHAR(20) NOT NULL DEFAULT 'sets';
+
+-- A drawn match is completed with no winner (round robin and swiss only)
+ALTER TABLE matches ADD COLUMN draw BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+-- Drop stations table
+DROP TABLE IF EXISTS stations;
@@ -0,0 +1,21 @@
+-- Add stations table: the numbered setups, streams and courts of a tournament
+-- A station holds at most one match at a time; match_id is NULL while it is free
+
+CREATE TABLE stations (
+    id BIGSERIAL PRIMARY KEY,
+    tournament_id BIGINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    stream BOOLEAN NOT NULL DEFAULT FALSE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    match_id BIGINT UNIQUE REFERENCES matches(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_stations_tournament_id ON stations(tournament_id);
+
+-- Reuse existing trigger function for updated_at
+CREATE TRIGGER update_stations_updated_at
+    BEFORE UPDATE ON stations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,5 @@
+-- Add 'called' to match_status enum
+-- A ready match is called to its station and both participants must check in before
+-- the deadline, or a no-show forfeits
+
+ALTER TYPE match_status ADD VALUE 'called';
@@ -0,0 +1,8 @@
+-- Remove check-in columns
+DROP INDEX IF EXISTS idx_matches_check_in_deadline;
+ALTER TABLE matches DROP COLUMN IF EXISTS participant2_checked_in;
+ALTER TABLE matches DROP COLUMN IF EXISTS participant1_checked_in;
+ALTER TABLE matches DROP COLUMN IF EXISTS check_in_deadline;
+
+-- Called matches go back to ready
+UPDATE matches SET status = 'ready' WHERE status = 'called';
@@ -0,0 +1,8 @@
+-- Add check-in tracking for called matches. 'called' is added to match_status in its
+-- own migration, since a new enum value cannot be used in the transaction adding it.
+ALTER TABLE matches ADD COLUMN check_in_deadline TIMESTAMP;
+ALTER TABLE matches ADD COLUMN participant1_checked_in BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE matches ADD COLUMN participant2_checked_in BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The check-in timer looks up expired calls across every tournament
+CREATE INDEX idx_matches_check_in_deadline ON matches(check_in_deadline) WHERE status = 'called';
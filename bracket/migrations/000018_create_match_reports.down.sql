@@ -0,0 +1,6 @@
+-- Drop match reports table
+DROP TABLE IF EXISTS match_reports;
+
+-- PostgreSQL cannot easily remove enum values, so 'disputed' stays in match_status.
+-- Disputed matches go back to in progress.
+UPDATE matches SET status = 'in_progress' WHERE status = 'disputed';
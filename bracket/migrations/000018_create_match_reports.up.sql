@@ -0,0 +1,26 @@
+-- Add match reports: results submitted by a match's participants. A report stands
+-- once the opponent confirms it or its confirm_deadline passes; a counter-report with
+-- a different result puts the match in the disputed state for the organizer to settle.
+ALTER TYPE match_status ADD VALUE 'disputed';
+
+CREATE TABLE match_reports (
+    id BIGSERIAL PRIMARY KEY,
+    match_id BIGINT NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    participant_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    sets JSONB NOT NULL DEFAULT '[]',
+    outcome VARCHAR(20),
+    confirm_deadline TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (match_id, participant_id)
+);
+
+-- The confirmation timer looks up expired reports across every tournament
+CREATE INDEX idx_match_reports_confirm_deadline ON match_reports(confirm_deadline);
+
+-- Reuse existing trigger function for updated_at
+CREATE TRIGGER update_match_reports_updated_at
+    BEFORE UPDATE ON match_reports
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
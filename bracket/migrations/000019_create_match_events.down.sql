@@ -0,0 +1,2 @@
+-- Drop match events table
+DROP TABLE IF EXISTS match_events;
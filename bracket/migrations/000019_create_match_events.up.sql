@@ -0,0 +1,18 @@
+-- Add match events: an audit log of every change to a match's result, with the
+-- result before and after, who made the change and why.
+-- match_id has no foreign key so the log outlives regenerated brackets.
+
+CREATE TABLE match_events (
+    id BIGSERIAL PRIMARY KEY,
+    tournament_id BIGINT NOT NULL,
+    match_id BIGINT NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    user_id BIGINT,
+    reason TEXT NOT NULL DEFAULT '',
+    before JSONB NOT NULL,
+    after JSONB NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_match_events_match_id ON match_events(match_id);
+CREATE INDEX idx_match_events_tournament_id ON match_events(tournament_id);
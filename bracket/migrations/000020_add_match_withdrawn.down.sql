@@ -0,0 +1,6 @@
+-- Withdrawn participants were flagged as disqualified before
+UPDATE matches SET participant1_disqualified = TRUE WHERE participant1_withdrawn;
+UPDATE matches SET participant2_disqualified = TRUE WHERE participant2_withdrawn;
+
+ALTER TABLE matches DROP COLUMN IF EXISTS participant2_withdrawn;
+ALTER TABLE matches DROP COLUMN IF EXISTS participant1_withdrawn;
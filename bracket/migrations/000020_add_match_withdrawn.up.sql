@@ -0,0 +1,5 @@
+-- Add withdrawn tracking to matches: a participant who withdrew while waiting for an
+-- opponent forfeits to whoever is placed here, like a disqualified one, but is not
+-- shown as disqualified.
+ALTER TABLE matches ADD COLUMN participant1_withdrawn BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE matches ADD COLUMN participant2_withdrawn BOOLEAN NOT NULL DEFAULT FALSE;
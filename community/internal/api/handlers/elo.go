@@ -136,6 +136,11 @@ type ProcessMatchEloRequest struct {
 	TournamentID   uint64 `json:"tournament_id"`
 	WinnerMemberID uint64 `json:"winner_member_id"`
 	LoserMemberID  uint64 `json:"loser_member_id"`
+	Draw           bool   `json:"draw,omitempty"` // Winner and loser are just the two players
+}
+
+type VoidMatchEloRequest struct {
+	MatchID uint64 `json:"match_id"`
 }
 
 type ProcessMatchEloResponse struct {
@@ -147,6 +152,19 @@ type ProcessMatchEloResponse struct {
 	LoserChange        int `json:"loser_change"`
 }
 
+type MemberRatingsLookupRequest struct {
+	MemberIDs []uint64 `json:"member_ids"`
+}
+
+// MemberRatingsLookupResponse returns the members' ratings along with the system
+// settings needed to place members who are unrated or still provisional.
+type MemberRatingsLookupResponse struct {
+	EloSystemID      uint64                    `json:"elo_system_id"`
+	StartingRating   int                       `json:"starting_rating"`
+	ProvisionalGames int                       `json:"provisional_games"`
+	Ratings          []MemberEloRatingResponse `json:"ratings"`
+}
+
 // Helper functions
 
 func toEloSystemResponse(s *domain.EloSystem) EloSystemResponse {
@@ -641,6 +659,7 @@ func (h *EloHandler) ProcessMatch(w http.ResponseWriter, r *http.Request) {
 		TournamentID:   req.TournamentID,
 		WinnerMemberID: req.WinnerMemberID,
 		LoserMemberID:  req.LoserMemberID,
+		Draw:           req.Draw,
 	})
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "Failed to process match ELO: "+err.Error())
@@ -657,6 +676,71 @@ func (h *EloHandler) ProcessMatch(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// VoidMatch is an internal endpoint for undoing the ELO changes of a voided match result
+func (h *EloHandler) VoidMatch(w http.ResponseWriter, r *http.Request) {
+	var req VoidMatchEloRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.MatchID == 0 {
+		writeError(w, http.StatusBadRequest, "match_id is required")
+		return
+	}
+
+	if err := h.eloService.VoidMatchResult(r.Context(), req.MatchID); err != nil {
+		writeError(w, http.StatusInternalServerError, "Failed to void match ELO: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// LookupMemberRatings is an internal endpoint for getting the ratings of a set of members in a system
+func (h *EloHandler) LookupMemberRatings(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid system ID")
+		return
+	}
+
+	var req MemberRatingsLookupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	system, err := h.eloService.GetSystem(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrEloSystemNotFound) {
+			writeError(w, http.StatusNotFound, "ELO system not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "Failed to get ELO system")
+		return
+	}
+
+	ratings, err := h.eloService.GetRatingsForMembers(r.Context(), id, req.MemberIDs)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Failed to get member ratings")
+		return
+	}
+
+	resp := MemberRatingsLookupResponse{
+		EloSystemID:      system.ID,
+		StartingRating:   system.StartingRating,
+		ProvisionalGames: system.ProvisionalGames,
+		Ratings:          make([]MemberEloRatingResponse, len(ratings)),
+	}
+	for i, rating := range ratings {
+		resp.Ratings[i] = toMemberEloRatingResponse(rating)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
 // GetSystemByID is an internal endpoint for getting a system by ID
 func (h *EloHandler) GetSystemByID(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
@@ -43,7 +43,9 @@ func NewRouter(
 		})
 		r.Route("/elo", func(r chi.Router) {
 			r.Post("/process-match", eloHandler.ProcessMatch)
+			r.Post("/void-match", eloHandler.VoidMatch)
 			r.Get("/systems/{id}", eloHandler.GetSystemByID)
+			r.Post("/systems/{id}/ratings", eloHandler.LookupMemberRatings)
 		})
 	})
 
@@ -21,6 +21,7 @@ type MemberRepository interface {
 	Delete(ctx context.Context, id uint64) error
 	GetLeaderboard(ctx context.Context, communityID uint64, limit int) ([]*domain.CommunityMember, error)
 	IncrementMatchStats(ctx context.Context, memberID uint64, won bool, newEloRating *int) error
+	RevertMatchStats(ctx context.Context, memberID uint64, won bool, newEloRating *int) error
 }
 
 type memberRepository struct {
@@ -222,6 +223,43 @@ func (r *memberRepository) IncrementMatchStats(ctx context.Context, memberID uin
 	return nil
 }
 
+// RevertMatchStats takes back a match counted by IncrementMatchStats, e.g. when its
+// result is voided.
+func (r *memberRepository) RevertMatchStats(ctx context.Context, memberID uint64, won bool, newEloRating *int) error {
+	var query string
+	if won {
+		query = `
+			UPDATE community_members
+			SET matches_played = GREATEST(matches_played - 1, 0),
+			    matches_won = GREATEST(matches_won - 1, 0),
+			    elo_rating = $1
+			WHERE id = $2
+		`
+	} else {
+		query = `
+			UPDATE community_members
+			SET matches_played = GREATEST(matches_played - 1, 0),
+			    elo_rating = $1
+			WHERE id = $2
+		`
+	}
+
+	result, err := r.db.ExecContext(ctx, query, newEloRating, memberID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrMemberNotFound
+	}
+
+	return nil
+}
+
 func (r *memberRepository) queryMembers(ctx context.Context, query string, args ...any) ([]*domain.CommunityMember, error) {
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
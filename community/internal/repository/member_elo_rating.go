@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/braccet/community/internal/domain"
+	"github.com/lib/pq"
 )
 
 var ErrMemberEloRatingNotFound = errors.New("member elo rating not found")
@@ -16,6 +17,7 @@ type MemberEloRatingRepository interface {
 	GetByID(ctx context.Context, id uint64) (*domain.MemberEloRating, error)
 	GetByMemberAndSystem(ctx context.Context, memberID, systemID uint64) (*domain.MemberEloRating, error)
 	GetByMember(ctx context.Context, memberID uint64) ([]*domain.MemberEloRating, error)
+	GetByMembersAndSystem(ctx context.Context, memberIDs []uint64, systemID uint64) ([]*domain.MemberEloRating, error)
 	GetLeaderboard(ctx context.Context, systemID uint64, limit int) ([]*domain.MemberEloRating, error)
 	Update(ctx context.Context, r *domain.MemberEloRating) error
 	Delete(ctx context.Context, id uint64) error
@@ -129,6 +131,45 @@ func (r *memberEloRatingRepository) GetByMember(ctx context.Context, memberID ui
 	return ratings, rows.Err()
 }
 
+// GetByMembersAndSystem returns the ratings the given members have in a system. Members
+// without a rating in the system are left out.
+func (r *memberEloRatingRepository) GetByMembersAndSystem(ctx context.Context, memberIDs []uint64, systemID uint64) ([]*domain.MemberEloRating, error) {
+	if len(memberIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT mer.id, mer.member_id, mer.elo_system_id, mer.rating, mer.games_played, mer.games_won,
+			mer.current_win_streak, mer.highest_rating, mer.lowest_rating, mer.last_game_at,
+			mer.created_at, mer.updated_at, cm.display_name
+		FROM member_elo_ratings mer
+		JOIN community_members cm ON cm.id = mer.member_id
+		WHERE mer.member_id = ANY($1) AND mer.elo_system_id = $2
+		ORDER BY mer.rating DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(memberIDs), systemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ratings []*domain.MemberEloRating
+	for rows.Next() {
+		rating := &domain.MemberEloRating{}
+		err := rows.Scan(
+			&rating.ID, &rating.MemberID, &rating.EloSystemID, &rating.Rating, &rating.GamesPlayed, &rating.GamesWon,
+			&rating.CurrentWinStreak, &rating.HighestRating, &rating.LowestRating, &rating.LastGameAt,
+			&rating.CreatedAt, &rating.UpdatedAt, &rating.MemberDisplayName,
+		)
+		if err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, rating)
+	}
+
+	return ratings, rows.Err()
+}
+
 func (r *memberEloRatingRepository) GetLeaderboard(ctx context.Context, systemID uint64, limit int) ([]*domain.MemberEloRating, error) {
 	query := `
 		SELECT mer.id, mer.member_id, mer.elo_system_id, mer.rating, mer.games_played, mer.games_won,
@@ -14,13 +14,15 @@ var (
 	ErrMemberNotFound    = errors.New("member not found")
 )
 
-// ProcessMatchRequest contains the data needed to process ELO updates for a match
+// ProcessMatchRequest contains the data needed to process ELO updates for a match.
+// For a draw, WinnerMemberID and LoserMemberID are simply the two players.
 type ProcessMatchRequest struct {
 	EloSystemID    uint64
 	MatchID        uint64
 	TournamentID   uint64
 	WinnerMemberID uint64
 	LoserMemberID  uint64
+	Draw           bool
 }
 
 // ProcessMatchResponse contains the results of ELO processing
@@ -45,10 +47,12 @@ type EloService interface {
 	// Rating operations
 	GetMemberRating(ctx context.Context, memberID, systemID uint64) (*domain.MemberEloRating, error)
 	GetMemberRatings(ctx context.Context, memberID uint64) ([]*domain.MemberEloRating, error)
+	GetRatingsForMembers(ctx context.Context, systemID uint64, memberIDs []uint64) ([]*domain.MemberEloRating, error)
 	GetLeaderboard(ctx context.Context, systemID uint64, limit int) ([]*domain.MemberEloRating, error)
 
 	// Match result processing
 	ProcessMatchResult(ctx context.Context, req ProcessMatchRequest) (*ProcessMatchResponse, error)
+	VoidMatchResult(ctx context.Context, matchID uint64) error
 
 	// History
 	GetMemberHistory(ctx context.Context, memberID, systemID uint64, limit int) ([]*domain.EloHistory, error)
@@ -115,6 +119,12 @@ func (s *eloService) GetMemberRatings(ctx context.Context, memberID uint64) ([]*
 	return s.ratingRepo.GetByMember(ctx, memberID)
 }
 
+// GetRatingsForMembers retrieves the ratings a set of members have in a system.
+// Members who have not played in the system yet have no rating and are left out.
+func (s *eloService) GetRatingsForMembers(ctx context.Context, systemID uint64, memberIDs []uint64) ([]*domain.MemberEloRating, error) {
+	return s.ratingRepo.GetByMembersAndSystem(ctx, memberIDs, systemID)
+}
+
 // GetLeaderboard retrieves the top-rated members for a system
 func (s *eloService) GetLeaderboard(ctx context.Context, systemID uint64, limit int) ([]*domain.MemberEloRating, error) {
 	if limit <= 0 {
@@ -163,13 +173,17 @@ func (s *eloService) ProcessMatchResult(ctx context.Context, req ProcessMatchReq
 	loserK := s.getKFactor(system, loserRating)
 
 	// Calculate base rating changes
-	// Winner gets actualScore = 1.0, Loser gets actualScore = 0.0
-	winnerChange := int(math.Round(float64(winnerK) * (1.0 - winnerExpected)))
-	loserChange := int(math.Round(float64(loserK) * (0.0 - loserExpected)))
+	// Winner gets actualScore = 1.0, Loser gets actualScore = 0.0; a draw is 0.5 each
+	winnerScore, loserScore := 1.0, 0.0
+	if req.Draw {
+		winnerScore, loserScore = 0.5, 0.5
+	}
+	winnerChange := int(math.Round(float64(winnerK) * (winnerScore - winnerExpected)))
+	loserChange := int(math.Round(float64(loserK) * (loserScore - loserExpected)))
 
 	// Apply win streak bonus
 	winStreakBonus := 0
-	if system.WinStreakEnabled {
+	if system.WinStreakEnabled && !req.Draw {
 		newStreak := winnerRating.CurrentWinStreak + 1
 		if newStreak >= system.WinStreakThreshold {
 			winStreakBonus = system.WinStreakBonus
@@ -185,11 +199,15 @@ func (s *eloService) ProcessMatchResult(ctx context.Context, req ProcessMatchReq
 	actualWinnerChange := winnerNewRating - winnerRatingBefore
 	actualLoserChange := loserNewRating - loserRatingBefore
 
-	// Update winner rating
+	// Update winner rating (a draw ends the streak without counting as a win)
 	winnerRating.Rating = winnerNewRating
 	winnerRating.GamesPlayed++
-	winnerRating.GamesWon++
-	winnerRating.CurrentWinStreak++
+	if req.Draw {
+		winnerRating.CurrentWinStreak = 0
+	} else {
+		winnerRating.GamesWon++
+		winnerRating.CurrentWinStreak++
+	}
 	winnerRating.HighestRating = max(winnerRating.HighestRating, winnerNewRating)
 	if err := s.ratingRepo.Update(ctx, winnerRating); err != nil {
 		return nil, err
@@ -204,8 +222,13 @@ func (s *eloService) ProcessMatchResult(ctx context.Context, req ProcessMatchReq
 		return nil, err
 	}
 
-	// Record history for winner
-	isWinnerTrue := true
+	// Record history for winner. Neither player is the winner of a draw
+	isWinnerTrue := !req.Draw
+	var notes *string
+	if req.Draw {
+		draw := "draw"
+		notes = &draw
+	}
 	winnerHistory := &domain.EloHistory{
 		MemberID:             req.WinnerMemberID,
 		EloSystemID:          req.EloSystemID,
@@ -221,6 +244,7 @@ func (s *eloService) ProcessMatchResult(ctx context.Context, req ProcessMatchReq
 		KFactorUsed:          &winnerK,
 		ExpectedScore:        &winnerExpected,
 		WinStreakBonus:       winStreakBonus,
+		Notes:                notes,
 	}
 	if err := s.historyRepo.Create(ctx, winnerHistory); err != nil {
 		return nil, err
@@ -242,13 +266,14 @@ func (s *eloService) ProcessMatchResult(ctx context.Context, req ProcessMatchReq
 		IsWinner:             &isWinnerFalse,
 		KFactorUsed:          &loserK,
 		ExpectedScore:        &loserExpected,
+		Notes:                notes,
 	}
 	if err := s.historyRepo.Create(ctx, loserHistory); err != nil {
 		return nil, err
 	}
 
 	// Update community_members stats (matches_played, matches_won, elo_rating)
-	if err := s.memberRepo.IncrementMatchStats(ctx, req.WinnerMemberID, true, &winnerNewRating); err != nil {
+	if err := s.memberRepo.IncrementMatchStats(ctx, req.WinnerMemberID, !req.Draw, &winnerNewRating); err != nil {
 		// Log but don't fail - ELO was already updated successfully
 		// This is a denormalized cache field
 	}
@@ -266,6 +291,81 @@ func (s *eloService) ProcessMatchResult(ctx context.Context, req ProcessMatchReq
 	}, nil
 }
 
+// VoidMatchResult undoes the rating changes of a match whose result was voided (e.g. a
+// disqualification). Each player's latest change for the match is reversed and an
+// adjustment is recorded in their history; matches that were never rated, or whose
+// changes were already voided, are left alone. The rating is restored exactly, so the
+// floor is not applied, and a winner's streak loses the win.
+func (s *eloService) VoidMatchResult(ctx context.Context, matchID uint64) error {
+	history, err := s.historyRepo.GetByMatch(ctx, matchID)
+	if err != nil {
+		return err
+	}
+
+	// A player's match entries that have no void recorded against them yet
+	rated := make(map[uint64][]*domain.EloHistory)
+	voided := make(map[uint64]int)
+	for _, h := range history {
+		switch h.ChangeType {
+		case domain.EloChangeMatch:
+			rated[h.MemberID] = append(rated[h.MemberID], h)
+		case domain.EloChangeAdjustment:
+			voided[h.MemberID]++
+		}
+	}
+
+	notes := "match result voided"
+	for memberID, entries := range rated {
+		if len(entries) <= voided[memberID] {
+			continue
+		}
+		entry := entries[0]
+		for _, e := range entries[1:] {
+			if e.ID > entry.ID {
+				entry = e
+			}
+		}
+		won := entry.IsWinner != nil && *entry.IsWinner
+
+		rating, err := s.ratingRepo.GetByMemberAndSystem(ctx, memberID, entry.EloSystemID)
+		if err != nil {
+			return err
+		}
+		ratingBefore := rating.Rating
+		rating.Rating -= entry.RatingChange
+		rating.GamesPlayed = max(rating.GamesPlayed-1, 0)
+		if won {
+			rating.GamesWon = max(rating.GamesWon-1, 0)
+			rating.CurrentWinStreak = max(rating.CurrentWinStreak-1, 0)
+		}
+		if err := s.ratingRepo.Update(ctx, rating); err != nil {
+			return err
+		}
+
+		if err := s.historyRepo.Create(ctx, &domain.EloHistory{
+			MemberID:         memberID,
+			EloSystemID:      entry.EloSystemID,
+			ChangeType:       domain.EloChangeAdjustment,
+			RatingBefore:     ratingBefore,
+			RatingChange:     rating.Rating - ratingBefore,
+			RatingAfter:      rating.Rating,
+			MatchID:          entry.MatchID,
+			TournamentID:     entry.TournamentID,
+			OpponentMemberID: entry.OpponentMemberID,
+			IsWinner:         entry.IsWinner,
+			Notes:            &notes,
+		}); err != nil {
+			return err
+		}
+
+		if err := s.memberRepo.RevertMatchStats(ctx, memberID, won, &rating.Rating); err != nil {
+			// Log but don't fail - this is a denormalized cache field
+		}
+	}
+
+	return nil
+}
+
 // calculateExpectedScore computes the expected score using the ELO formula
 // E_A = 1 / (1 + 10^((R_B - R_A) / 400))
 func (s *eloService) calculateExpectedScore(ratingA, ratingB int) float64 {
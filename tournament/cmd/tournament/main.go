@@ -22,7 +22,9 @@ func main() {
 
 	// Initialize repositories
 	tournamentRepo := repository.NewTournamentRepository(db)
+	stageRepo := repository.NewStageRepository(db)
 	participantRepo := repository.NewParticipantRepository(db)
+	teamRepo := repository.NewTeamRepository(db)
 
 	// Initialize bracket service client
 	bracketServiceURL := os.Getenv("BRACKET_SERVICE_URL")
@@ -39,7 +41,7 @@ func main() {
 	communityClient := client.NewCommunityClient(communityServiceURL)
 
 	// Create router
-	router := api.NewRouter(tournamentRepo, participantRepo, bracketClient, communityClient)
+	router := api.NewRouter(tournamentRepo, stageRepo, participantRepo, teamRepo, bracketClient, communityClient)
 
 	// Get port from environment
 	port := os.Getenv("PORT")
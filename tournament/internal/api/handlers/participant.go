@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
@@ -17,14 +19,16 @@ import (
 
 type ParticipantHandler struct {
 	participantRepo repository.ParticipantRepository
+	teamRepo        repository.TeamRepository
 	tournamentRepo  repository.TournamentRepository
 	bracketClient   client.BracketClient
 	communityClient client.CommunityClient
 }
 
-func NewParticipantHandler(participantRepo repository.ParticipantRepository, tournamentRepo repository.TournamentRepository, bracketClient client.BracketClient, communityClient client.CommunityClient) *ParticipantHandler {
+func NewParticipantHandler(participantRepo repository.ParticipantRepository, teamRepo repository.TeamRepository, tournamentRepo repository.TournamentRepository, bracketClient client.BracketClient, communityClient client.CommunityClient) *ParticipantHandler {
 	return &ParticipantHandler{
 		participantRepo: participantRepo,
+		teamRepo:        teamRepo,
 		tournamentRepo:  tournamentRepo,
 		bracketClient:   bracketClient,
 		communityClient: communityClient,
@@ -37,6 +41,21 @@ type AddParticipantRequest struct {
 	UserID            *uint64 `json:"user_id,omitempty"`
 	CommunityMemberID *uint64 `json:"community_member_id,omitempty"`
 	DisplayName       string  `json:"display_name"`
+	Tag               *string `json:"tag,omitempty"`
+}
+
+type SubstituteRequest struct {
+	UserID            *uint64 `json:"user_id,omitempty"`
+	CommunityMemberID *uint64 `json:"community_member_id,omitempty"`
+	DisplayName       string  `json:"display_name"`
+}
+
+type DisqualifyRequest struct {
+	VoidCurrentRound bool `json:"void_current_round"` // Also void results already played in the current round
+}
+
+type UpdateTagRequest struct {
+	Tag *string `json:"tag"` // null clears the tag
 }
 
 type UpdateSeedingRequest struct {
@@ -49,10 +68,16 @@ type ParticipantResponse struct {
 	UserID            *uint64 `json:"user_id,omitempty"`
 	CommunityMemberID *uint64 `json:"community_member_id,omitempty"`
 	DisplayName       string  `json:"display_name"`
+	Tag               *string `json:"tag,omitempty"`
 	Seed              *uint   `json:"seed,omitempty"`
 	Status            string  `json:"status"`
+	SubstituteForID   *uint64 `json:"substitute_for_id,omitempty"`
 	CheckedInAt       *string `json:"checked_in_at,omitempty"`
 	CreatedAt         string  `json:"created_at"`
+
+	// Team tournaments only: the team's captain and roster
+	CaptainUserID *uint64              `json:"captain_user_id,omitempty"`
+	Members       []TeamMemberResponse `json:"members,omitempty"`
 }
 
 func toParticipantResponse(p *domain.Participant) ParticipantResponse {
@@ -62,8 +87,10 @@ func toParticipantResponse(p *domain.Participant) ParticipantResponse {
 		UserID:            p.UserID,
 		CommunityMemberID: p.CommunityMemberID,
 		DisplayName:       p.DisplayName,
+		Tag:               p.Tag,
 		Seed:              p.Seed,
 		Status:            string(p.Status),
+		SubstituteForID:   p.SubstituteForID,
 		CreatedAt:         p.CreatedAt.Format(time.RFC3339),
 	}
 	if p.CheckedInAt != nil {
@@ -73,6 +100,28 @@ func toParticipantResponse(p *domain.Participant) ParticipantResponse {
 	return resp
 }
 
+// toParticipantResponses converts participants to responses, attaching team rosters
+// in team tournaments.
+func (h *ParticipantHandler) toParticipantResponses(ctx context.Context, tournamentID uint64, participants []*domain.Participant) ([]ParticipantResponse, error) {
+	teams, err := h.teamRepo.GetByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	byParticipant := make(map[uint64]*domain.Team, len(teams))
+	for _, t := range teams {
+		byParticipant[t.ParticipantID] = t
+	}
+
+	response := make([]ParticipantResponse, len(participants))
+	for i, p := range participants {
+		response[i] = toParticipantResponse(p)
+		if team, ok := byParticipant[p.ID]; ok {
+			response[i] = withTeam(response[i], team)
+		}
+	}
+	return response, nil
+}
+
 // GetByID returns a single participant by ID (internal endpoint)
 func (h *ParticipantHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
@@ -92,7 +141,17 @@ func (h *ParticipantHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSON(w, http.StatusOK, toParticipantResponse(participant))
+	// Team members are included so downstream services (e.g. ELO) can reach the players
+	resp := toParticipantResponse(participant)
+	team, err := h.teamRepo.GetByParticipantID(r.Context(), id)
+	if err == nil {
+		resp = withTeam(resp, team)
+	} else if !errors.Is(err, repository.ErrTeamNotFound) {
+		writeError(w, http.StatusInternalServerError, "failed to fetch team")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // List returns all participants for a tournament
@@ -120,9 +179,11 @@ func (h *ParticipantHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := make([]ParticipantResponse, len(participants))
-	for i, p := range participants {
-		response[i] = toParticipantResponse(p)
+	response, err := h.toParticipantResponses(r.Context(), tournament.ID, participants)
+	if err != nil {
+		log.Printf("Error fetching teams for tournament %d: %v", tournament.ID, err)
+		writeError(w, http.StatusInternalServerError, "failed to fetch teams")
+		return
 	}
 
 	writeJSON(w, http.StatusOK, response)
@@ -165,6 +226,24 @@ func (h *ParticipantHandler) Add(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	settings, err := tournament.ParseSettings()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to parse tournament settings")
+		return
+	}
+	if settings.IsTeamTournament() {
+		writeError(w, http.StatusBadRequest, "team tournaments register teams with a roster")
+		return
+	}
+
+	// Once the bracket is running, late entries go into open byes and only the
+	// organizer can add them
+	lateEntry := tournament.Status == domain.StatusInProgress
+	if lateEntry && !isOrganizer {
+		writeError(w, http.StatusForbidden, "only the organizer can add participants once the tournament has started")
+		return
+	}
+
 	// Authorization logic:
 	// - Organizer can add anyone
 	// - Non-organizer can only self-register if registration is open
@@ -224,15 +303,44 @@ func (h *ParticipantHandler) Add(w http.ResponseWriter, r *http.Request) {
 		UserID:            req.UserID,
 		CommunityMemberID: req.CommunityMemberID,
 		DisplayName:       req.DisplayName,
+		Tag:               req.Tag,
 		Status:            domain.ParticipantRegistered,
 	}
 
+	// A late entrant is seeded last
+	if lateEntry {
+		count, err := h.participantRepo.CountByTournament(r.Context(), tournament.ID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to check participant count")
+			return
+		}
+		seed := uint(count + 1)
+		participant.Seed = &seed
+	}
+
 	if err := h.participantRepo.Create(r.Context(), participant); err != nil {
 		log.Printf("Error creating participant: %v", err)
 		writeError(w, http.StatusInternalServerError, "failed to add participant")
 		return
 	}
 
+	if lateEntry {
+		err := h.bracketClient.AddLateEntrant(r.Context(), tournament.ID, participant.ID, participant.DisplayName, *participant.Seed)
+		if err != nil {
+			// Don't leave a participant behind who has no place in the bracket
+			if delErr := h.participantRepo.Delete(r.Context(), participant.ID); delErr != nil {
+				log.Printf("Error removing late entrant %d: %v", participant.ID, delErr)
+			}
+			if errors.Is(err, client.ErrNoOpenByeSlot) {
+				writeError(w, http.StatusConflict, "bracket has no open bye slot for a late entry")
+				return
+			}
+			log.Printf("Error placing late entrant in bracket: %v", err)
+			writeError(w, http.StatusInternalServerError, "failed to add participant to the bracket")
+			return
+		}
+	}
+
 	// Fetch the created participant
 	created, err := h.participantRepo.GetByID(r.Context(), participant.ID)
 	if err != nil {
@@ -361,14 +469,228 @@ func (h *ParticipantHandler) UpdateSeeding(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	response := make([]ParticipantResponse, len(participants))
+	response, err := h.toParticipantResponses(r.Context(), tournament.ID, participants)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch teams")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// UpdateTag sets or clears a participant's tag (organizer only)
+func (h *ParticipantHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	slug := chi.URLParam(r, "slug")
+	if slug == "" {
+		writeError(w, http.StatusBadRequest, "invalid tournament slug")
+		return
+	}
+
+	participantIDStr := chi.URLParam(r, "participantId")
+	participantID, err := strconv.ParseUint(participantIDStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid participant id")
+		return
+	}
+
+	tournament, err := h.tournamentRepo.GetBySlug(r.Context(), slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrTournamentNotFound) {
+			writeError(w, http.StatusNotFound, "tournament not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament")
+		return
+	}
+
+	// Only organizer can tag participants
+	if tournament.OrganizerID != userID {
+		writeError(w, http.StatusForbidden, "only the organizer can update tags")
+		return
+	}
+
+	participant, err := h.participantRepo.GetByID(r.Context(), participantID)
+	if err != nil {
+		if errors.Is(err, repository.ErrParticipantNotFound) {
+			writeError(w, http.StatusNotFound, "participant not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch participant")
+		return
+	}
+
+	// Verify participant belongs to this tournament
+	if participant.TournamentID != tournament.ID {
+		writeError(w, http.StatusNotFound, "participant not found in this tournament")
+		return
+	}
+
+	var req UpdateTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Tag != nil && *req.Tag == "" {
+		req.Tag = nil
+	}
+
+	if err := h.participantRepo.UpdateTag(r.Context(), participantID, req.Tag); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to update tag")
+		return
+	}
+	participant.Tag = req.Tag
+
+	writeJSON(w, http.StatusOK, toParticipantResponse(participant))
+}
+
+// AutoSeed seeds participants by their rating in the tournament's ELO system (organizer only)
+func (h *ParticipantHandler) AutoSeed(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	slug := chi.URLParam(r, "slug")
+	if slug == "" {
+		writeError(w, http.StatusBadRequest, "invalid tournament slug")
+		return
+	}
+
+	tournament, err := h.tournamentRepo.GetBySlug(r.Context(), slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrTournamentNotFound) {
+			writeError(w, http.StatusNotFound, "tournament not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament")
+		return
+	}
+
+	// Only organizer can update seeding
+	if tournament.OrganizerID != userID {
+		writeError(w, http.StatusForbidden, "only the organizer can update seeding")
+		return
+	}
+
+	if tournament.EloSystemID == nil {
+		writeError(w, http.StatusBadRequest, "tournament has no ELO system to seed by")
+		return
+	}
+
+	settings, err := tournament.ParseSettings()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to parse tournament settings")
+		return
+	}
+
+	participants, err := h.participantRepo.GetByTournament(r.Context(), tournament.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch participants")
+		return
+	}
+	if len(participants) == 0 {
+		writeError(w, http.StatusBadRequest, "tournament has no participants")
+		return
+	}
+
+	teams, err := h.teamRepo.GetByTournament(r.Context(), tournament.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch teams")
+		return
+	}
+
+	// Community members who play for each participant: the participant itself, or a team's players
+	players := make(map[uint64][]uint64, len(participants))
+	var memberIDs []uint64
+	for _, p := range participants {
+		if p.CommunityMemberID != nil {
+			players[p.ID] = []uint64{*p.CommunityMemberID}
+			memberIDs = append(memberIDs, *p.CommunityMemberID)
+		}
+	}
+	for _, t := range teams {
+		for _, m := range t.Members {
+			if !m.Substitute && m.CommunityMemberID != nil {
+				players[t.ParticipantID] = append(players[t.ParticipantID], *m.CommunityMemberID)
+				memberIDs = append(memberIDs, *m.CommunityMemberID)
+			}
+		}
+	}
+
+	ratings, err := h.communityClient.GetMemberRatings(r.Context(), *tournament.EloSystemID, memberIDs)
+	if err != nil {
+		log.Printf("Error fetching ratings for ELO system %d: %v", *tournament.EloSystemID, err)
+		writeError(w, http.StatusInternalServerError, "failed to fetch ratings")
+		return
+	}
+
+	byMember := make(map[uint64]client.MemberRating, len(ratings.Ratings))
+	for _, rating := range ratings.Ratings {
+		byMember[rating.MemberID] = rating
+	}
+
+	playerRatings := make([]domain.PlayerRating, len(participants))
 	for i, p := range participants {
-		response[i] = toParticipantResponse(p)
+		playerRatings[i] = combineRatings(p.ID, players[p.ID], byMember, ratings)
+	}
+
+	seeds := domain.SeedByRating(playerRatings, settings.UnratedSeeding, ratings.StartingRating)
+	if err := h.participantRepo.UpdateSeeding(r.Context(), tournament.ID, seeds); err != nil {
+		log.Printf("Error updating seeding: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to update seeding")
+		return
+	}
+
+	// Return updated participants list
+	participants, err = h.participantRepo.GetByTournament(r.Context(), tournament.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch participants")
+		return
+	}
+
+	response, err := h.toParticipantResponses(r.Context(), tournament.ID, participants)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch teams")
+		return
 	}
 
 	writeJSON(w, http.StatusOK, response)
 }
 
+// combineRatings rates a participant from the ratings of the members who play for it.
+// A team is rated on the average of its players, counting unrated ones at the starting
+// rating, and stays provisional until every player is established.
+func combineRatings(participantID uint64, memberIDs []uint64, byMember map[uint64]client.MemberRating, ratings *client.MemberRatingsResponse) domain.PlayerRating {
+	result := domain.PlayerRating{ParticipantID: participantID}
+	if len(memberIDs) == 0 {
+		return result
+	}
+
+	total := 0
+	for _, id := range memberIDs {
+		rating, ok := byMember[id]
+		if !ok {
+			total += ratings.StartingRating
+			result.Provisional = true
+			continue
+		}
+		total += rating.Rating
+		result.Rated = true
+		if rating.GamesPlayed < ratings.ProvisionalGames {
+			result.Provisional = true
+		}
+	}
+	result.Rating = total / len(memberIDs)
+	return result
+}
+
 // Withdraw withdraws a participant from an in-progress tournament
 func (h *ParticipantHandler) Withdraw(w http.ResponseWriter, r *http.Request) {
 	userID, ok := middleware.GetUserID(r.Context())
@@ -446,10 +768,262 @@ func (h *ParticipantHandler) Withdraw(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Notify bracket service to process forfeits
-	if err := h.bracketClient.ProcessWithdrawal(r.Context(), tournament.ID, participantID); err != nil {
+	if err := h.bracketClient.ProcessWithdrawal(r.Context(), tournament.ID, participantID, userID); err != nil {
 		log.Printf("Warning: failed to process bracket forfeit: %v", err)
 		// Don't fail the request - status is updated, bracket service can retry
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// Disqualify disqualifies a participant from an in-progress tournament (organizer only).
+// Their open matches are forfeited to their opponents; results they already played in
+// the current round can be voided too.
+func (h *ParticipantHandler) Disqualify(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	slug := chi.URLParam(r, "slug")
+	if slug == "" {
+		writeError(w, http.StatusBadRequest, "invalid tournament slug")
+		return
+	}
+
+	participantIDStr := chi.URLParam(r, "participantId")
+	participantID, err := strconv.ParseUint(participantIDStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid participant id")
+		return
+	}
+
+	tournament, err := h.tournamentRepo.GetBySlug(r.Context(), slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrTournamentNotFound) {
+			writeError(w, http.StatusNotFound, "tournament not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament")
+		return
+	}
+
+	if tournament.OrganizerID != userID {
+		writeError(w, http.StatusForbidden, "only the organizer can disqualify participants")
+		return
+	}
+
+	if tournament.Status != domain.StatusInProgress {
+		writeError(w, http.StatusBadRequest, "disqualification only allowed during in-progress tournaments")
+		return
+	}
+
+	participant, err := h.participantRepo.GetByID(r.Context(), participantID)
+	if err != nil {
+		if errors.Is(err, repository.ErrParticipantNotFound) {
+			writeError(w, http.StatusNotFound, "participant not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch participant")
+		return
+	}
+
+	// Verify participant belongs to this tournament
+	if participant.TournamentID != tournament.ID {
+		writeError(w, http.StatusNotFound, "participant not found in this tournament")
+		return
+	}
+
+	// Cannot disqualify someone who is already out of the tournament
+	if participant.Status == domain.ParticipantEliminated ||
+		participant.Status == domain.ParticipantDisqualified ||
+		participant.Status == domain.ParticipantWithdrawn ||
+		participant.Status == domain.ParticipantSubstituted {
+		writeError(w, http.StatusBadRequest, "participant is not active in tournament")
+		return
+	}
+
+	// The body is optional
+	var req DisqualifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.participantRepo.UpdateStatus(r.Context(), participantID, domain.ParticipantDisqualified); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to update participant status")
+		return
+	}
+
+	// Notify bracket service to process forfeits
+	if err := h.bracketClient.ProcessDisqualification(r.Context(), tournament.ID, participantID, userID, req.VoidCurrentRound); err != nil {
+		log.Printf("Warning: failed to process bracket disqualification: %v", err)
+		// Don't fail the request - status is updated, bracket service can retry
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Substitute replaces a participant with a substitute in an in-progress tournament
+// (organizer only). The substitute takes over the participant's seed and their place
+// in every match they have not played yet, instead of the opponents getting a forfeit.
+func (h *ParticipantHandler) Substitute(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	slug := chi.URLParam(r, "slug")
+	if slug == "" {
+		writeError(w, http.StatusBadRequest, "invalid tournament slug")
+		return
+	}
+
+	participantIDStr := chi.URLParam(r, "participantId")
+	participantID, err := strconv.ParseUint(participantIDStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid participant id")
+		return
+	}
+
+	tournament, err := h.tournamentRepo.GetBySlug(r.Context(), slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrTournamentNotFound) {
+			writeError(w, http.StatusNotFound, "tournament not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament")
+		return
+	}
+
+	if tournament.OrganizerID != userID {
+		writeError(w, http.StatusForbidden, "only the organizer can substitute participants")
+		return
+	}
+
+	if tournament.Status != domain.StatusInProgress {
+		writeError(w, http.StatusBadRequest, "substitution only allowed during in-progress tournaments")
+		return
+	}
+
+	settings, err := tournament.ParseSettings()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to parse tournament settings")
+		return
+	}
+	if settings.IsTeamTournament() {
+		writeError(w, http.StatusBadRequest, "team tournaments substitute players through the team roster")
+		return
+	}
+
+	participant, err := h.participantRepo.GetByID(r.Context(), participantID)
+	if err != nil {
+		if errors.Is(err, repository.ErrParticipantNotFound) {
+			writeError(w, http.StatusNotFound, "participant not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch participant")
+		return
+	}
+
+	// Verify participant belongs to this tournament
+	if participant.TournamentID != tournament.ID {
+		writeError(w, http.StatusNotFound, "participant not found in this tournament")
+		return
+	}
+
+	// Cannot substitute someone who is already out of the tournament
+	if participant.Status == domain.ParticipantEliminated ||
+		participant.Status == domain.ParticipantDisqualified ||
+		participant.Status == domain.ParticipantWithdrawn ||
+		participant.Status == domain.ParticipantSubstituted {
+		writeError(w, http.StatusBadRequest, "participant is not active in tournament")
+		return
+	}
+
+	var req SubstituteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.DisplayName == "" {
+		writeError(w, http.StatusBadRequest, "display_name is required")
+		return
+	}
+
+	// Check for duplicate registration (only if user_id is provided)
+	if req.UserID != nil {
+		existing, err := h.participantRepo.GetByTournamentAndUser(r.Context(), tournament.ID, *req.UserID)
+		if err == nil && existing != nil {
+			writeError(w, http.StatusConflict, "user is already registered for this tournament")
+			return
+		}
+		if err != nil && !errors.Is(err, repository.ErrParticipantNotFound) {
+			writeError(w, http.StatusInternalServerError, "failed to check existing registration")
+			return
+		}
+	}
+
+	// For community tournaments: auto-create ghost member if no community_member_id provided
+	if tournament.CommunityID != nil && req.CommunityMemberID == nil {
+		member, err := h.communityClient.CreateGhostMember(r.Context(), *tournament.CommunityID, req.DisplayName)
+		if err != nil {
+			log.Printf("Error creating ghost member for community %d: %v", *tournament.CommunityID, err)
+			writeError(w, http.StatusInternalServerError, "failed to create community member")
+			return
+		}
+		req.CommunityMemberID = &member.ID
+	}
+
+	sub := &domain.Participant{
+		TournamentID:      tournament.ID,
+		UserID:            req.UserID,
+		CommunityMemberID: req.CommunityMemberID,
+		DisplayName:       req.DisplayName,
+		Tag:               participant.Tag,
+		Seed:              participant.Seed,
+		Status:            participant.Status,
+	}
+
+	if err := h.participantRepo.Substitute(r.Context(), participant.ID, sub); err != nil {
+		log.Printf("Error substituting participant %d: %v", participant.ID, err)
+		writeError(w, http.StatusInternalServerError, "failed to substitute participant")
+		return
+	}
+
+	bracketSub := client.SubstituteParticipant{ID: sub.ID, Name: sub.DisplayName}
+	if sub.Seed != nil {
+		bracketSub.Seed = *sub.Seed
+	}
+	if err := h.bracketClient.SubstituteParticipant(r.Context(), tournament.ID, participant.ID, bracketSub); err != nil {
+		// Undo the substitution so the records match the bracket
+		if delErr := h.participantRepo.Delete(r.Context(), sub.ID); delErr != nil {
+			log.Printf("Error removing substitute %d: %v", sub.ID, delErr)
+		}
+		if statusErr := h.participantRepo.UpdateStatus(r.Context(), participant.ID, participant.Status); statusErr != nil {
+			log.Printf("Error restoring status of participant %d: %v", participant.ID, statusErr)
+		}
+		if errors.Is(err, client.ErrMatchInProgress) {
+			writeError(w, http.StatusConflict, "participant is in the middle of a match")
+			return
+		}
+		if errors.Is(err, client.ErrSubstituteInStage) {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Printf("Error substituting participant in bracket: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to substitute participant in the bracket")
+		return
+	}
+
+	created, err := h.participantRepo.GetByID(r.Context(), sub.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch substitute")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toParticipantResponse(created))
+}
@@ -0,0 +1,374 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/braccet/tournament/internal/api/middleware"
+	"github.com/braccet/tournament/internal/client"
+	"github.com/braccet/tournament/internal/domain"
+	"github.com/braccet/tournament/internal/repository"
+	"github.com/go-chi/chi/v5"
+)
+
+type TeamHandler struct {
+	teamRepo        repository.TeamRepository
+	participantRepo repository.ParticipantRepository
+	tournamentRepo  repository.TournamentRepository
+	communityClient client.CommunityClient
+}
+
+func NewTeamHandler(teamRepo repository.TeamRepository, participantRepo repository.ParticipantRepository, tournamentRepo repository.TournamentRepository, communityClient client.CommunityClient) *TeamHandler {
+	return &TeamHandler{
+		teamRepo:        teamRepo,
+		participantRepo: participantRepo,
+		tournamentRepo:  tournamentRepo,
+		communityClient: communityClient,
+	}
+}
+
+// Request/Response types
+
+type RegisterTeamRequest struct {
+	Name          string              `json:"name"`
+	Tag           *string             `json:"tag,omitempty"`
+	CaptainUserID *uint64             `json:"captain_user_id,omitempty"`
+	Members       []TeamMemberRequest `json:"members"`
+}
+
+type UpdateRosterRequest struct {
+	CaptainUserID *uint64             `json:"captain_user_id,omitempty"` // Omit to keep the current captain
+	Members       []TeamMemberRequest `json:"members"`
+}
+
+type TeamMemberRequest struct {
+	UserID            *uint64 `json:"user_id,omitempty"`
+	CommunityMemberID *uint64 `json:"community_member_id,omitempty"`
+	DisplayName       string  `json:"display_name"`
+	Substitute        bool    `json:"substitute"`
+}
+
+type TeamMemberResponse struct {
+	ID                uint64  `json:"id"`
+	UserID            *uint64 `json:"user_id,omitempty"`
+	CommunityMemberID *uint64 `json:"community_member_id,omitempty"`
+	DisplayName       string  `json:"display_name"`
+	Substitute        bool    `json:"substitute"`
+}
+
+func toTeamMembers(reqs []TeamMemberRequest) []domain.TeamMember {
+	members := make([]domain.TeamMember, len(reqs))
+	for i, req := range reqs {
+		members[i] = domain.TeamMember{
+			UserID:            req.UserID,
+			CommunityMemberID: req.CommunityMemberID,
+			DisplayName:       req.DisplayName,
+			Substitute:        req.Substitute,
+		}
+	}
+	return members
+}
+
+// withTeam attaches a team's captain and roster to its participant response.
+func withTeam(resp ParticipantResponse, team *domain.Team) ParticipantResponse {
+	resp.CaptainUserID = team.CaptainUserID
+	resp.Members = make([]TeamMemberResponse, len(team.Members))
+	for i, m := range team.Members {
+		resp.Members[i] = TeamMemberResponse{
+			ID:                m.ID,
+			UserID:            m.UserID,
+			CommunityMemberID: m.CommunityMemberID,
+			DisplayName:       m.DisplayName,
+			Substitute:        m.Substitute,
+		}
+	}
+	return resp
+}
+
+// Register registers a team as a participant in a team tournament
+func (h *TeamHandler) Register(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	tournament, settings, ok := h.getTeamTournament(w, r)
+	if !ok {
+		return
+	}
+
+	var req RegisterTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	// Authorization logic:
+	// - Organizer can register any team
+	// - Non-organizer can only register a team they captain if registration is open
+	if tournament.OrganizerID != userID {
+		if !tournament.RegistrationOpen {
+			writeError(w, http.StatusForbidden, "registration is closed")
+			return
+		}
+		if req.CaptainUserID != nil && *req.CaptainUserID != userID {
+			writeError(w, http.StatusForbidden, "you can only register a team you captain")
+			return
+		}
+		req.CaptainUserID = &userID
+	}
+
+	team := &domain.Team{
+		CaptainUserID: req.CaptainUserID,
+		Members:       toTeamMembers(req.Members),
+	}
+	if err := team.ValidateRoster(settings.TeamSize, settings.MaxSubstitutes); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !h.checkRosterAvailable(w, r, tournament.ID, team) {
+		return
+	}
+
+	// Check max participants limit
+	if tournament.MaxParticipants != nil {
+		count, err := h.participantRepo.CountByTournament(r.Context(), tournament.ID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to check participant count")
+			return
+		}
+		if count >= int(*tournament.MaxParticipants) {
+			writeError(w, http.StatusConflict, "tournament has reached maximum participants")
+			return
+		}
+	}
+
+	if err := h.linkCommunityMembers(r.Context(), tournament, team, nil); err != nil {
+		log.Printf("Error creating ghost members for community %d: %v", *tournament.CommunityID, err)
+		writeError(w, http.StatusInternalServerError, "failed to create community members")
+		return
+	}
+
+	participant := &domain.Participant{
+		TournamentID: tournament.ID,
+		DisplayName:  req.Name,
+		Tag:          req.Tag,
+		Status:       domain.ParticipantRegistered,
+	}
+
+	if err := h.teamRepo.Create(r.Context(), participant, team); err != nil {
+		log.Printf("Error creating team: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to register team")
+		return
+	}
+
+	// Fetch the created participant
+	created, err := h.participantRepo.GetByID(r.Context(), participant.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch created participant")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, withTeam(toParticipantResponse(created), team))
+}
+
+// UpdateRoster replaces a team's roster (organizer or team captain)
+func (h *TeamHandler) UpdateRoster(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	participantIDStr := chi.URLParam(r, "participantId")
+	participantID, err := strconv.ParseUint(participantIDStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid participant id")
+		return
+	}
+
+	tournament, settings, ok := h.getTeamTournament(w, r)
+	if !ok {
+		return
+	}
+
+	team, err := h.teamRepo.GetByParticipantID(r.Context(), participantID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			writeError(w, http.StatusNotFound, "team not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch team")
+		return
+	}
+
+	// Verify team belongs to this tournament
+	if team.TournamentID != tournament.ID {
+		writeError(w, http.StatusNotFound, "team not found in this tournament")
+		return
+	}
+
+	isOrganizer := tournament.OrganizerID == userID
+	isCaptain := team.CaptainUserID != nil && *team.CaptainUserID == userID
+
+	// Authorization: organizer can edit any roster, captains can edit their own
+	if !isOrganizer && !isCaptain {
+		writeError(w, http.StatusForbidden, "only the organizer or team captain can update the roster")
+		return
+	}
+
+	if tournament.Status == domain.StatusCompleted || tournament.Status == domain.StatusCancelled {
+		writeError(w, http.StatusBadRequest, "roster cannot be changed after the tournament has ended")
+		return
+	}
+
+	var req UpdateRosterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.CaptainUserID != nil {
+		team.CaptainUserID = req.CaptainUserID
+	}
+	current := team.Members
+	team.Members = toTeamMembers(req.Members)
+	if err := team.ValidateRoster(settings.TeamSize, settings.MaxSubstitutes); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !h.checkRosterAvailable(w, r, tournament.ID, team) {
+		return
+	}
+
+	if err := h.linkCommunityMembers(r.Context(), tournament, team, current); err != nil {
+		log.Printf("Error creating ghost members for community %d: %v", *tournament.CommunityID, err)
+		writeError(w, http.StatusInternalServerError, "failed to create community members")
+		return
+	}
+
+	if err := h.teamRepo.UpdateRoster(r.Context(), team); err != nil {
+		log.Printf("Error updating roster for team %d: %v", team.ID, err)
+		writeError(w, http.StatusInternalServerError, "failed to update roster")
+		return
+	}
+
+	participant, err := h.participantRepo.GetByID(r.Context(), participantID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch participant")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, withTeam(toParticipantResponse(participant), team))
+}
+
+// getTeamTournament loads the tournament from the URL and its settings, writing an
+// error response and returning false if it is missing or not a team tournament.
+func (h *TeamHandler) getTeamTournament(w http.ResponseWriter, r *http.Request) (*domain.Tournament, domain.TournamentSettings, bool) {
+	slug := chi.URLParam(r, "slug")
+	if slug == "" {
+		writeError(w, http.StatusBadRequest, "invalid tournament slug")
+		return nil, domain.TournamentSettings{}, false
+	}
+
+	tournament, err := h.tournamentRepo.GetBySlug(r.Context(), slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrTournamentNotFound) {
+			writeError(w, http.StatusNotFound, "tournament not found")
+			return nil, domain.TournamentSettings{}, false
+		}
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament")
+		return nil, domain.TournamentSettings{}, false
+	}
+
+	settings, err := tournament.ParseSettings()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to parse tournament settings")
+		return nil, domain.TournamentSettings{}, false
+	}
+	if !settings.IsTeamTournament() {
+		writeError(w, http.StatusBadRequest, "tournament is not a team tournament")
+		return nil, domain.TournamentSettings{}, false
+	}
+
+	return tournament, settings, true
+}
+
+// checkRosterAvailable verifies that no one on the roster already plays for another
+// team in the tournament, writing a conflict response and returning false if so.
+func (h *TeamHandler) checkRosterAvailable(w http.ResponseWriter, r *http.Request, tournamentID uint64, team *domain.Team) bool {
+	for _, m := range team.Members {
+		if m.UserID == nil {
+			continue
+		}
+		existing, err := h.teamRepo.GetByTournamentAndUser(r.Context(), tournamentID, *m.UserID)
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamNotFound) {
+				continue
+			}
+			writeError(w, http.StatusInternalServerError, "failed to check existing registration")
+			return false
+		}
+		if existing.ID != team.ID {
+			writeError(w, http.StatusConflict, fmt.Sprintf("user %d is already on a team in this tournament", *m.UserID))
+			return false
+		}
+	}
+	return true
+}
+
+// linkCommunityMembers creates ghost members for roster members of a community
+// tournament who are not linked to a community member yet, so ratings can follow them.
+// Players already on the current roster keep their link instead of getting a new ghost.
+func (h *TeamHandler) linkCommunityMembers(ctx context.Context, tournament *domain.Tournament, team *domain.Team, current []domain.TeamMember) error {
+	if tournament.CommunityID == nil {
+		return nil
+	}
+	for i := range team.Members {
+		m := &team.Members[i]
+		if m.CommunityMemberID != nil {
+			continue
+		}
+		if existing := findRosterMember(current, m); existing != nil && existing.CommunityMemberID != nil {
+			m.CommunityMemberID = existing.CommunityMemberID
+			continue
+		}
+		member, err := h.communityClient.CreateGhostMember(ctx, *tournament.CommunityID, m.DisplayName)
+		if err != nil {
+			return err
+		}
+		m.CommunityMemberID = &member.ID
+	}
+	return nil
+}
+
+// findRosterMember returns the roster entry for the same player as m: the one with the
+// same user, or for players without an account, the one with the same display name.
+func findRosterMember(roster []domain.TeamMember, m *domain.TeamMember) *domain.TeamMember {
+	for i := range roster {
+		r := &roster[i]
+		if m.UserID != nil {
+			if r.UserID != nil && *r.UserID == *m.UserID {
+				return r
+			}
+			continue
+		}
+		if r.UserID == nil && r.DisplayName == m.DisplayName {
+			return r
+		}
+	}
+	return nil
+}
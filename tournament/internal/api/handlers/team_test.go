@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/braccet/tournament/internal/api/middleware"
+	"github.com/braccet/tournament/internal/client"
+	"github.com/braccet/tournament/internal/domain"
+	"github.com/braccet/tournament/internal/repository"
+	"github.com/go-chi/chi/v5"
+)
+
+// Fakes embed the interfaces they stand in for, so only the methods a test exercises
+// need implementing.
+
+type fakeTeamRepository struct {
+	repository.TeamRepository
+	team *domain.Team
+}
+
+func (r *fakeTeamRepository) GetByParticipantID(ctx context.Context, participantID uint64) (*domain.Team, error) {
+	if r.team.ParticipantID != participantID {
+		return nil, repository.ErrTeamNotFound
+	}
+	team := *r.team
+	team.Members = append([]domain.TeamMember(nil), r.team.Members...)
+	return &team, nil
+}
+
+func (r *fakeTeamRepository) GetByTournamentAndUser(ctx context.Context, tournamentID, userID uint64) (*domain.Team, error) {
+	return nil, repository.ErrTeamNotFound
+}
+
+func (r *fakeTeamRepository) UpdateRoster(ctx context.Context, team *domain.Team) error {
+	r.team.CaptainUserID = team.CaptainUserID
+	r.team.Members = append([]domain.TeamMember(nil), team.Members...)
+	return nil
+}
+
+type fakeTournamentRepository struct {
+	repository.TournamentRepository
+	tournament *domain.Tournament
+}
+
+func (r *fakeTournamentRepository) GetBySlug(ctx context.Context, slug string) (*domain.Tournament, error) {
+	return r.tournament, nil
+}
+
+type fakeParticipantRepository struct {
+	repository.ParticipantRepository
+}
+
+func (r *fakeParticipantRepository) GetByID(ctx context.Context, id uint64) (*domain.Participant, error) {
+	return &domain.Participant{ID: id, DisplayName: "Team"}, nil
+}
+
+type fakeCommunityClient struct {
+	client.CommunityClient
+	ghosts int
+}
+
+func (c *fakeCommunityClient) CreateGhostMember(ctx context.Context, communityID uint64, displayName string) (*client.MemberResponse, error) {
+	c.ghosts++
+	return &client.MemberResponse{ID: uint64(100 + c.ghosts), DisplayName: displayName}, nil
+}
+
+func TestUpdateRoster_ReusesCommunityMembers(t *testing.T) {
+	communityID := uint64(7)
+	settings, _ := json.Marshal(domain.TournamentSettings{TeamSize: 2})
+	tournament := &domain.Tournament{ID: 1, Slug: "cup", OrganizerID: 1, CommunityID: &communityID, Settings: settings}
+	teams := &fakeTeamRepository{team: &domain.Team{ID: 1, TournamentID: 1, ParticipantID: 5}}
+	community := &fakeCommunityClient{}
+	h := NewTeamHandler(teams, &fakeParticipantRepository{}, &fakeTournamentRepository{tournament: tournament}, community)
+
+	player := uint64(2)
+	body, _ := json.Marshal(UpdateRosterRequest{Members: []TeamMemberRequest{
+		{UserID: &player, DisplayName: "Player"},
+		{DisplayName: "Guest"},
+	}})
+	update := func() {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPut, "/tournaments/cup/teams/5", bytes.NewReader(body))
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("slug", "cup")
+		rctx.URLParams.Add("participantId", "5")
+		ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+		ctx = context.WithValue(ctx, middleware.UserIDKey, tournament.OrganizerID)
+
+		rec := httptest.NewRecorder()
+		h.UpdateRoster(rec, req.WithContext(ctx))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	update()
+	if community.ghosts != 2 {
+		t.Fatalf("expected a ghost member for each player, got %d", community.ghosts)
+	}
+	linked := teams.team.Members
+
+	// The same roster again keeps the existing links
+	update()
+	if community.ghosts != 2 {
+		t.Errorf("expected no new ghost members, got %d more", community.ghosts-2)
+	}
+	for i, m := range teams.team.Members {
+		if m.CommunityMemberID == nil || *m.CommunityMemberID != *linked[i].CommunityMemberID {
+			t.Errorf("expected %s to keep community member %d", m.DisplayName, *linked[i].CommunityMemberID)
+		}
+	}
+}
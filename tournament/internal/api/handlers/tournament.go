@@ -17,11 +17,15 @@ import (
 )
 
 type TournamentHandler struct {
-	repo repository.TournamentRepository
+	repo      repository.TournamentRepository
+	stageRepo repository.StageRepository
 }
 
-func NewTournamentHandler(repo repository.TournamentRepository) *TournamentHandler {
-	return &TournamentHandler{repo: repo}
+func NewTournamentHandler(repo repository.TournamentRepository, stageRepo repository.StageRepository) *TournamentHandler {
+	return &TournamentHandler{
+		repo:      repo,
+		stageRepo: stageRepo,
+	}
 }
 
 // Request/Response types
@@ -35,6 +39,15 @@ type CreateTournamentRequest struct {
 	StartsAt        *string `json:"starts_at,omitempty"`
 	CommunityID     *uint64 `json:"community_id,omitempty"`
 	EloSystemID     *uint64 `json:"elo_system_id,omitempty"`
+
+	Settings *domain.TournamentSettings `json:"settings,omitempty"`
+	Stages   []StageRequest             `json:"stages,omitempty"` // Required for multi_stage tournaments
+}
+
+type StageRequest struct {
+	Format            string `json:"format"`
+	PoolCount         int    `json:"pool_count,omitempty"`
+	QualifiersPerPool int    `json:"qualifiers_per_pool,omitempty"`
 }
 
 type UpdateTournamentRequest struct {
@@ -48,6 +61,11 @@ type UpdateTournamentRequest struct {
 	StartsAt         *string `json:"starts_at,omitempty"`
 	CommunityID      *uint64 `json:"community_id,omitempty"`
 	EloSystemID      *uint64 `json:"elo_system_id,omitempty"`
+
+	// Settings is merged into the existing settings; omitted keys are left unchanged
+	Settings json.RawMessage `json:"settings,omitempty"`
+	// Stages replaces all existing stages when present
+	Stages *[]StageRequest `json:"stages,omitempty"`
 }
 
 type TournamentResponse struct {
@@ -66,6 +84,16 @@ type TournamentResponse struct {
 	StartsAt         *string `json:"starts_at,omitempty"`
 	CreatedAt        string  `json:"created_at"`
 	UpdatedAt        string  `json:"updated_at"`
+
+	Settings domain.TournamentSettings `json:"settings"`
+	Stages   []StageResponse           `json:"stages,omitempty"`
+}
+
+type StageResponse struct {
+	StageNumber       int    `json:"stage_number"`
+	Format            string `json:"format"`
+	PoolCount         int    `json:"pool_count"`
+	QualifiersPerPool int    `json:"qualifiers_per_pool"`
 }
 
 type ErrorResponse struct {
@@ -115,9 +143,72 @@ func toTournamentResponse(t *domain.Tournament) TournamentResponse {
 		startsAt := t.StartsAt.Format(time.RFC3339)
 		resp.StartsAt = &startsAt
 	}
+	if settings, err := t.ParseSettings(); err == nil {
+		resp.Settings = settings
+	} else {
+		log.Printf("Error parsing settings for tournament %d: %v", t.ID, err)
+	}
 	return resp
 }
 
+func toStages(reqs []StageRequest) []domain.Stage {
+	stages := make([]domain.Stage, len(reqs))
+	for i, req := range reqs {
+		stages[i] = domain.Stage{
+			StageNumber:       i + 1,
+			Format:            domain.TournamentFormat(req.Format),
+			PoolCount:         max(req.PoolCount, 1),
+			QualifiersPerPool: req.QualifiersPerPool,
+		}
+	}
+	return stages
+}
+
+// mergeJSON overlays patch on base: objects are merged key by key, anything else in
+// patch replaces what base has, and keys only in base are kept as they are.
+func mergeJSON(base, patch json.RawMessage) (json.RawMessage, error) {
+	var patchObj map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &patchObj); err != nil || patchObj == nil {
+		return patch, nil
+	}
+	var baseObj map[string]json.RawMessage
+	if err := json.Unmarshal(base, &baseObj); err != nil || baseObj == nil {
+		baseObj = make(map[string]json.RawMessage, len(patchObj))
+	}
+
+	for key, value := range patchObj {
+		merged, err := mergeJSON(baseObj[key], value)
+		if err != nil {
+			return nil, err
+		}
+		baseObj[key] = merged
+	}
+	return json.Marshal(baseObj)
+}
+
+// withStages returns the tournament response with its stages attached.
+func (h *TournamentHandler) withStages(r *http.Request, t *domain.Tournament) (TournamentResponse, error) {
+	resp := toTournamentResponse(t)
+	if t.Format != domain.FormatMultiStage {
+		return resp, nil
+	}
+
+	stages, err := h.stageRepo.GetByTournament(r.Context(), t.ID)
+	if err != nil {
+		return resp, err
+	}
+	resp.Stages = make([]StageResponse, len(stages))
+	for i, s := range stages {
+		resp.Stages[i] = StageResponse{
+			StageNumber:       s.StageNumber,
+			Format:            string(s.Format),
+			PoolCount:         s.PoolCount,
+			QualifiersPerPool: s.QualifiersPerPool,
+		}
+	}
+	return resp, nil
+}
+
 // List returns all tournaments for the authenticated user
 func (h *TournamentHandler) List(w http.ResponseWriter, r *http.Request) {
 	userID, ok := middleware.GetUserID(r.Context())
@@ -185,8 +276,8 @@ func (h *TournamentHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	format := domain.TournamentFormat(req.Format)
-	if format != domain.FormatSingleElimination && format != domain.FormatDoubleElimination {
-		writeError(w, http.StatusBadRequest, "format must be 'single_elimination' or 'double_elimination'")
+	if !format.IsValid() {
+		writeError(w, http.StatusBadRequest, "format must be 'single_elimination', 'double_elimination', 'round_robin', 'swiss', 'multi_stage' or 'free_for_all'")
 		return
 	}
 
@@ -205,6 +296,25 @@ func (h *TournamentHandler) Create(w http.ResponseWriter, r *http.Request) {
 		Settings:         json.RawMessage(`{}`),
 	}
 
+	stages := toStages(req.Stages)
+	if err := domain.ValidateStages(format, stages); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Settings != nil {
+		if err := req.Settings.Validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		settings, err := json.Marshal(req.Settings)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid settings")
+			return
+		}
+		tournament.Settings = settings
+	}
+
 	if req.StartsAt != nil {
 		startsAt, err := time.Parse(time.RFC3339, *req.StartsAt)
 		if err != nil {
@@ -220,6 +330,14 @@ func (h *TournamentHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if len(stages) > 0 {
+		if err := h.stageRepo.ReplaceForTournament(r.Context(), tournament.ID, stages); err != nil {
+			log.Printf("Error creating stages for tournament %d: %v", tournament.ID, err)
+			writeError(w, http.StatusInternalServerError, "failed to create tournament stages")
+			return
+		}
+	}
+
 	// Fetch the created tournament to get timestamps
 	created, err := h.repo.GetBySlug(r.Context(), tournament.Slug)
 	if err != nil {
@@ -227,7 +345,13 @@ func (h *TournamentHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, toTournamentResponse(created))
+	resp, err := h.withStages(r, created)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament stages")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
 }
 
 // Get returns a single tournament by slug
@@ -248,7 +372,13 @@ func (h *TournamentHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSON(w, http.StatusOK, toTournamentResponse(tournament))
+	resp, err := h.withStages(r, tournament)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament stages")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // GetByID returns a single tournament by ID (internal endpoint for service-to-service calls)
@@ -270,7 +400,13 @@ func (h *TournamentHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSON(w, http.StatusOK, toTournamentResponse(tournament))
+	resp, err := h.withStages(r, tournament)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament stages")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // Update updates a tournament
@@ -321,8 +457,8 @@ func (h *TournamentHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 	if req.Format != nil {
 		format := domain.TournamentFormat(*req.Format)
-		if format != domain.FormatSingleElimination && format != domain.FormatDoubleElimination {
-			writeError(w, http.StatusBadRequest, "format must be 'single_elimination' or 'double_elimination'")
+		if !format.IsValid() {
+			writeError(w, http.StatusBadRequest, "format must be 'single_elimination', 'double_elimination', 'round_robin', 'swiss', 'multi_stage' or 'free_for_all'")
 			return
 		}
 		tournament.Format = format
@@ -350,12 +486,70 @@ func (h *TournamentHandler) Update(w http.ResponseWriter, r *http.Request) {
 	if req.EloSystemID != nil {
 		tournament.EloSystemID = req.EloSystemID
 	}
+	if len(req.Settings) > 0 {
+		// Merged as raw JSON, so keys this service does not know about are kept
+		var patch map[string]json.RawMessage
+		if err := json.Unmarshal(req.Settings, &patch); err != nil || patch == nil {
+			writeError(w, http.StatusBadRequest, "invalid settings")
+			return
+		}
+		merged, err := mergeJSON(tournament.Settings, req.Settings)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to update tournament settings")
+			return
+		}
+		tournament.Settings = merged
+		settings, err := tournament.ParseSettings()
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid settings")
+			return
+		}
+		if err := settings.Validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	// Stages are checked whenever they or the format change. Leaving multi_stage
+	// without sending stages clears them.
+	var stages []domain.Stage
+	replaceStages := req.Stages != nil || req.Format != nil
+	if replaceStages {
+		if tournament.Status != domain.StatusRegistration {
+			writeError(w, http.StatusBadRequest, "format and stages cannot be changed after registration")
+			return
+		}
+		if req.Stages != nil {
+			stages = toStages(*req.Stages)
+		} else if tournament.Format == domain.FormatMultiStage {
+			existing, err := h.stageRepo.GetByTournament(r.Context(), tournament.ID)
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, "failed to fetch tournament stages")
+				return
+			}
+			for _, s := range existing {
+				stages = append(stages, *s)
+			}
+		}
+		if err := domain.ValidateStages(tournament.Format, stages); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
 
 	if err := h.repo.Update(r.Context(), tournament); err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to update tournament")
 		return
 	}
 
+	if replaceStages {
+		if err := h.stageRepo.ReplaceForTournament(r.Context(), tournament.ID, stages); err != nil {
+			log.Printf("Error updating stages for tournament %d: %v", tournament.ID, err)
+			writeError(w, http.StatusInternalServerError, "failed to update tournament stages")
+			return
+		}
+	}
+
 	// Fetch updated tournament
 	updated, err := h.repo.GetBySlug(r.Context(), slug)
 	if err != nil {
@@ -363,7 +557,13 @@ func (h *TournamentHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSON(w, http.StatusOK, toTournamentResponse(updated))
+	resp, err := h.withStages(r, updated)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch tournament stages")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // Delete deletes a tournament
@@ -11,7 +11,7 @@ import (
 	chimw "github.com/go-chi/chi/v5/middleware"
 )
 
-func NewRouter(tournamentRepo repository.TournamentRepository, participantRepo repository.ParticipantRepository, bracketClient client.BracketClient, communityClient client.CommunityClient) *chi.Mux {
+func NewRouter(tournamentRepo repository.TournamentRepository, stageRepo repository.StageRepository, participantRepo repository.ParticipantRepository, teamRepo repository.TeamRepository, bracketClient client.BracketClient, communityClient client.CommunityClient) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Middleware
@@ -26,8 +26,9 @@ func NewRouter(tournamentRepo repository.TournamentRepository, participantRepo r
 	})
 
 	// Tournament handlers
-	tournamentHandler := handlers.NewTournamentHandler(tournamentRepo)
-	participantHandler := handlers.NewParticipantHandler(participantRepo, tournamentRepo, bracketClient, communityClient)
+	tournamentHandler := handlers.NewTournamentHandler(tournamentRepo, stageRepo)
+	participantHandler := handlers.NewParticipantHandler(participantRepo, teamRepo, tournamentRepo, bracketClient, communityClient)
+	teamHandler := handlers.NewTeamHandler(teamRepo, participantRepo, tournamentRepo, communityClient)
 
 	// Internal routes (service-to-service, no auth required)
 	r.Route("/internal/tournaments", func(r chi.Router) {
@@ -61,7 +62,17 @@ func NewRouter(tournamentRepo repository.TournamentRepository, participantRepo r
 				r.Post("/", participantHandler.Add)
 				r.Delete("/{participantId}", participantHandler.Remove)
 				r.Post("/{participantId}/withdraw", participantHandler.Withdraw)
+				r.Post("/{participantId}/disqualify", participantHandler.Disqualify)
+				r.Post("/{participantId}/substitute", participantHandler.Substitute)
+				r.Put("/{participantId}/tag", participantHandler.UpdateTag)
 				r.Put("/seeding", participantHandler.UpdateSeeding)
+				r.Post("/seeding/auto", participantHandler.AutoSeed)
+			})
+
+			// Team routes (team tournaments register teams instead of single participants)
+			r.Route("/{slug}/teams", func(r chi.Router) {
+				r.Post("/", teamHandler.Register)
+				r.Put("/{participantId}/roster", teamHandler.UpdateRoster)
 			})
 		})
 	})
@@ -4,13 +4,36 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 )
 
+// ErrNoOpenByeSlot is returned when a late entrant cannot be placed because the
+// bracket has no unplayed bye left.
+var ErrNoOpenByeSlot = errors.New("no open bye slot for a late entry")
+
+// ErrMatchInProgress is returned when a participant cannot be substituted because they
+// are in the middle of a match.
+var ErrMatchInProgress = errors.New("participant has a match in progress")
+
+// ErrSubstituteInStage is returned when a participant cannot be substituted because the
+// bracket is in a round robin or Swiss stage.
+var ErrSubstituteInStage = errors.New("participants cannot be substituted in a round robin or Swiss stage")
+
 type BracketClient interface {
-	ProcessWithdrawal(ctx context.Context, tournamentID, participantID uint64) error
+	ProcessWithdrawal(ctx context.Context, tournamentID, participantID, userID uint64) error
+	ProcessDisqualification(ctx context.Context, tournamentID, participantID, userID uint64, voidCurrentRound bool) error
+	AddLateEntrant(ctx context.Context, tournamentID, participantID uint64, name string, seed uint) error
+	SubstituteParticipant(ctx context.Context, tournamentID, participantID uint64, substitute SubstituteParticipant) error
+}
+
+// SubstituteParticipant is the participant taking over another's bracket position.
+type SubstituteParticipant struct {
+	ID   uint64
+	Name string
+	Seed uint
 }
 
 type bracketClient struct {
@@ -30,13 +53,16 @@ func NewBracketClient(baseURL string) BracketClient {
 type forfeitRequest struct {
 	TournamentID  uint64 `json:"tournament_id"`
 	ParticipantID uint64 `json:"participant_id"`
+	UserID        uint64 `json:"user_id"` // Who withdrew the participant, for the bracket's audit log
 }
 
-// ProcessWithdrawal notifies the bracket service to forfeit matches for a withdrawn participant.
-func (c *bracketClient) ProcessWithdrawal(ctx context.Context, tournamentID, participantID uint64) error {
+// ProcessWithdrawal notifies the bracket service to forfeit matches for a participant
+// withdrawn by the given user.
+func (c *bracketClient) ProcessWithdrawal(ctx context.Context, tournamentID, participantID, userID uint64) error {
 	req := forfeitRequest{
 		TournamentID:  tournamentID,
 		ParticipantID: participantID,
+		UserID:        userID,
 	}
 
 	body, err := json.Marshal(req)
@@ -63,3 +89,141 @@ func (c *bracketClient) ProcessWithdrawal(ctx context.Context, tournamentID, par
 
 	return nil
 }
+
+type disqualifyRequest struct {
+	TournamentID     uint64 `json:"tournament_id"`
+	ParticipantID    uint64 `json:"participant_id"`
+	UserID           uint64 `json:"user_id"` // The organizer, for the bracket's audit log
+	VoidCurrentRound bool   `json:"void_current_round"`
+}
+
+// ProcessDisqualification notifies the bracket service to forfeit the matches of a
+// participant the given organizer disqualified and, if asked, void their results in
+// the current round.
+func (c *bracketClient) ProcessDisqualification(ctx context.Context, tournamentID, participantID, userID uint64, voidCurrentRound bool) error {
+	req := disqualifyRequest{
+		TournamentID:     tournamentID,
+		ParticipantID:    participantID,
+		UserID:           userID,
+		VoidCurrentRound: voidCurrentRound,
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/brackets/disqualify-participant", c.baseURL)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call bracket service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bracket service returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+type lateEntryRequest struct {
+	TournamentID    uint64 `json:"tournament_id"`
+	ParticipantID   uint64 `json:"participant_id"`
+	ParticipantName string `json:"participant_name"`
+	Seed            uint   `json:"seed"`
+}
+
+// AddLateEntrant asks the bracket service to place a participant who registered after
+// the bracket was generated into an open first round bye.
+func (c *bracketClient) AddLateEntrant(ctx context.Context, tournamentID, participantID uint64, name string, seed uint) error {
+	req := lateEntryRequest{
+		TournamentID:    tournamentID,
+		ParticipantID:   participantID,
+		ParticipantName: name,
+		Seed:            seed,
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/brackets/late-entry", c.baseURL)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call bracket service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusConflict {
+		return ErrNoOpenByeSlot
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bracket service returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+type substituteRequest struct {
+	TournamentID   uint64 `json:"tournament_id"`
+	ParticipantID  uint64 `json:"participant_id"`
+	SubstituteID   uint64 `json:"substitute_id"`
+	SubstituteName string `json:"substitute_name"`
+	SubstituteSeed uint   `json:"substitute_seed"`
+}
+
+// SubstituteParticipant asks the bracket service to hand a participant's pending and
+// ready matches to a substitute.
+func (c *bracketClient) SubstituteParticipant(ctx context.Context, tournamentID, participantID uint64, substitute SubstituteParticipant) error {
+	req := substituteRequest{
+		TournamentID:   tournamentID,
+		ParticipantID:  participantID,
+		SubstituteID:   substitute.ID,
+		SubstituteName: substitute.Name,
+		SubstituteSeed: substitute.Seed,
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/brackets/substitute", c.baseURL)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call bracket service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusConflict {
+		return ErrMatchInProgress
+	}
+	if resp.StatusCode == http.StatusBadRequest {
+		return ErrSubstituteInStage
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bracket service returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
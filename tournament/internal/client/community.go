@@ -13,6 +13,7 @@ type CommunityClient interface {
 	GetCommunity(ctx context.Context, communityID uint64) (*CommunityResponse, error)
 	GetMember(ctx context.Context, communityID, memberID uint64) (*MemberResponse, error)
 	CreateGhostMember(ctx context.Context, communityID uint64, displayName string) (*MemberResponse, error)
+	GetMemberRatings(ctx context.Context, eloSystemID uint64, memberIDs []uint64) (*MemberRatingsResponse, error)
 }
 
 type CommunityResponse struct {
@@ -30,6 +31,21 @@ type MemberResponse struct {
 	Role        string  `json:"role"`
 }
 
+// MemberRatingsResponse holds members' ratings in an ELO system. Members who have not
+// played in the system have no entry in Ratings.
+type MemberRatingsResponse struct {
+	EloSystemID      uint64         `json:"elo_system_id"`
+	StartingRating   int            `json:"starting_rating"`
+	ProvisionalGames int            `json:"provisional_games"`
+	Ratings          []MemberRating `json:"ratings"`
+}
+
+type MemberRating struct {
+	MemberID    uint64 `json:"member_id"`
+	Rating      int    `json:"rating"`
+	GamesPlayed int    `json:"games_played"`
+}
+
 type communityClient struct {
 	baseURL    string
 	httpClient *http.Client
@@ -138,3 +154,40 @@ func (c *communityClient) CreateGhostMember(ctx context.Context, communityID uin
 
 	return &member, nil
 }
+
+// GetMemberRatings fetches the members' ratings in an ELO system from the community service
+func (c *communityClient) GetMemberRatings(ctx context.Context, eloSystemID uint64, memberIDs []uint64) (*MemberRatingsResponse, error) {
+	url := fmt.Sprintf("%s/internal/elo/systems/%d/ratings", c.baseURL, eloSystemID)
+
+	reqBody := map[string][]uint64{"member_ids": memberIDs}
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call community service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("elo system not found")
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("community service returned status %d", resp.StatusCode)
+	}
+
+	var ratings MemberRatingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ratings); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &ratings, nil
+}
@@ -11,6 +11,7 @@ const (
 	ParticipantEliminated   ParticipantStatus = "eliminated"
 	ParticipantDisqualified ParticipantStatus = "disqualified"
 	ParticipantWithdrawn    ParticipantStatus = "withdrawn"
+	ParticipantSubstituted  ParticipantStatus = "substituted"
 )
 
 type Participant struct {
@@ -19,8 +20,10 @@ type Participant struct {
 	UserID            *uint64 // Pointer to allow nil for display-name-only participants
 	CommunityMemberID *uint64 // Links to community member for reuse across tournaments
 	DisplayName       string
+	Tag               *string // Region, club, etc.; brackets can keep participants sharing a tag apart
 	Seed              *uint
 	Status            ParticipantStatus
+	SubstituteForID   *uint64 // The participant whose bracket position this one took over, if any
 	CheckedInAt       *time.Time
 	CreatedAt         time.Time
 }
@@ -0,0 +1,52 @@
+package domain
+
+import "sort"
+
+// PlayerRating is what seeding by rating knows about a participant.
+type PlayerRating struct {
+	ParticipantID uint64
+	Rating        int
+	Rated         bool // Has a rating in the tournament's ELO system
+	Provisional   bool // Rated, but has not played the system's provisional games yet
+}
+
+// SeedByRating seeds participants from the highest rating down (seed 1 is the best).
+// With UnratedLast, established players come first, then provisional players by
+// rating, then unrated players. With UnratedRating everyone is ranked together and
+// unrated players count as startingRating. Ties keep the order players were given in.
+func SeedByRating(players []PlayerRating, policy string, startingRating int) map[uint64]uint {
+	type entry struct {
+		id     uint64
+		tier   int
+		rating int
+	}
+	entries := make([]entry, len(players))
+	for i, p := range players {
+		e := entry{id: p.ParticipantID, rating: p.Rating}
+		if !p.Rated {
+			e.rating = startingRating
+		}
+		if policy != UnratedRating {
+			switch {
+			case !p.Rated:
+				e.tier = 2
+			case p.Provisional:
+				e.tier = 1
+			}
+		}
+		entries[i] = e
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].tier != entries[j].tier {
+			return entries[i].tier < entries[j].tier
+		}
+		return entries[i].rating > entries[j].rating
+	})
+
+	seeds := make(map[uint64]uint, len(entries))
+	for i, e := range entries {
+		seeds[e.id] = uint(i + 1)
+	}
+	return seeds
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Stage is one phase of a multi-stage tournament, e.g. round robin pools followed by
+// an elimination playoff. Stages are played in StageNumber order.
+type Stage struct {
+	ID                uint64
+	TournamentID      uint64
+	StageNumber       int
+	Format            TournamentFormat
+	PoolCount         int // Number of pools the stage is split into (1 = no pools)
+	QualifiersPerPool int // How many from each pool advance to the next stage (0 for the last stage)
+	CreatedAt         time.Time
+}
+
+// ValidateStages checks a tournament's stage list against its format. Only multi-stage
+// tournaments have stages. Every stage but the last is a round robin pool stage that
+// sends qualifiers on; the last stage decides the champion and is not split into pools.
+func ValidateStages(format TournamentFormat, stages []Stage) error {
+	if format != FormatMultiStage {
+		if len(stages) > 0 {
+			return errors.New("stages are only allowed for multi_stage tournaments")
+		}
+		return nil
+	}
+
+	if len(stages) < 2 {
+		return errors.New("multi_stage tournaments need at least 2 stages")
+	}
+
+	for i, s := range stages {
+		if !s.Format.IsValid() || s.Format == FormatMultiStage || s.Format == FormatFreeForAll {
+			return fmt.Errorf("stage %d: invalid format %q", i+1, s.Format)
+		}
+		if s.PoolCount < 1 {
+			return fmt.Errorf("stage %d: pool_count must be at least 1", i+1)
+		}
+
+		if i == len(stages)-1 {
+			if s.PoolCount != 1 {
+				return fmt.Errorf("stage %d: the last stage cannot be split into pools", i+1)
+			}
+			continue
+		}
+
+		if s.Format != FormatRoundRobin {
+			return fmt.Errorf("stage %d: only the last stage can use %s", i+1, s.Format)
+		}
+		if s.QualifiersPerPool < 1 {
+			return fmt.Errorf("stage %d: qualifiers_per_pool must be at least 1", i+1)
+		}
+	}
+
+	return nil
+}
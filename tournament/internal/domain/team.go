@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Team is a roster of players that competes as a single participant. The participant's
+// display name is the team name, so brackets show teams like any other participant.
+type Team struct {
+	ID            uint64
+	TournamentID  uint64
+	ParticipantID uint64
+	CaptainUserID *uint64 // Can manage the roster along with the organizer
+	Members       []TeamMember
+	CreatedAt     time.Time
+}
+
+// TeamMember is one player on a team's roster.
+type TeamMember struct {
+	ID                uint64
+	TeamID            uint64
+	UserID            *uint64 // Pointer to allow nil for display-name-only players
+	CommunityMemberID *uint64 // Links to community member, e.g. for ELO
+	DisplayName       string
+	Substitute        bool
+	CreatedAt         time.Time
+}
+
+// ValidateRoster checks the roster against the tournament's team settings: exactly
+// teamSize players, at most maxSubstitutes substitutes, nobody listed twice, and a
+// captain (if any) who is on the roster.
+func (t *Team) ValidateRoster(teamSize, maxSubstitutes int) error {
+	players, substitutes := 0, 0
+	users := make(map[uint64]bool)
+	communityMembers := make(map[uint64]bool)
+	for _, m := range t.Members {
+		if m.DisplayName == "" {
+			return errors.New("every roster member needs a display_name")
+		}
+		if m.Substitute {
+			substitutes++
+		} else {
+			players++
+		}
+		if m.UserID != nil {
+			if users[*m.UserID] {
+				return fmt.Errorf("user %d is listed twice", *m.UserID)
+			}
+			users[*m.UserID] = true
+		}
+		if m.CommunityMemberID != nil {
+			if communityMembers[*m.CommunityMemberID] {
+				return fmt.Errorf("community member %d is listed twice", *m.CommunityMemberID)
+			}
+			communityMembers[*m.CommunityMemberID] = true
+		}
+	}
+
+	if players != teamSize {
+		return fmt.Errorf("roster needs exactly %d players, got %d", teamSize, players)
+	}
+	if substitutes > maxSubstitutes {
+		return fmt.Errorf("roster can have at most %d substitutes, got %d", maxSubstitutes, substitutes)
+	}
+	if t.CaptainUserID != nil && !users[*t.CaptainUserID] {
+		return errors.New("captain must be on the roster")
+	}
+	return nil
+}
+
+// HasUser reports whether the user is on the roster.
+func (t *Team) HasUser(userID uint64) bool {
+	for _, m := range t.Members {
+		if m.UserID != nil && *m.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 )
 
@@ -10,6 +12,48 @@ type TournamentFormat string
 const (
 	FormatSingleElimination TournamentFormat = "single_elimination"
 	FormatDoubleElimination TournamentFormat = "double_elimination"
+	FormatRoundRobin        TournamentFormat = "round_robin"
+	FormatSwiss             TournamentFormat = "swiss"
+	FormatMultiStage        TournamentFormat = "multi_stage"  // Formats come from the tournament's stages
+	FormatFreeForAll        TournamentFormat = "free_for_all" // Heats of more than two participants
+)
+
+// IsValid reports whether f is a supported tournament format.
+func (f TournamentFormat) IsValid() bool {
+	switch f {
+	case FormatSingleElimination, FormatDoubleElimination, FormatRoundRobin, FormatSwiss, FormatMultiStage,
+		FormatFreeForAll:
+		return true
+	}
+	return false
+}
+
+// Standings tiebreakers, applied in the order configured in TournamentSettings.Tiebreakers.
+const (
+	TiebreakerHeadToHead            = "head_to_head"
+	TiebreakerSetDifferential       = "set_differential"
+	TiebreakerSetsWon               = "sets_won"
+	TiebreakerBuchholz              = "buchholz"
+	TiebreakerOpponentWinPercentage = "opponent_win_percentage"
+)
+
+// Bracket sizing modes for fields that are not a power of two
+const (
+	SizingByes   = "byes"    // Round the bracket up; top seeds get byes
+	SizingPlayIn = "play_in" // Round the bracket down; lowest seeds play in
+)
+
+// How match results are reported
+const (
+	ScoringSets        = "sets"          // Set by set scores; whoever wins more sets wins
+	ScoringScore       = "score"         // A single final score
+	ScoringWinDrawLoss = "win_draw_loss" // Only the outcome
+)
+
+// Placement of unrated and provisional players when seeding by rating
+const (
+	UnratedLast   = "last"   // Seed them below every established player
+	UnratedRating = "rating" // Seed them by rating like everyone else (unrated at the starting rating)
 )
 
 type TournamentStatus string
@@ -39,3 +83,263 @@ type Tournament struct {
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
+
+// TournamentSettings holds per-tournament format options stored in the settings column.
+type TournamentSettings struct {
+	// GrandFinalReset plays a second grand final if the losers bracket finalist
+	// wins the first one (double elimination only).
+	GrandFinalReset bool `json:"grand_final_reset"`
+
+	// ThirdPlaceMatch adds a match between the semifinal losers to decide third
+	// place (single elimination only).
+	ThirdPlaceMatch bool `json:"third_place_match"`
+
+	// ConsolationBracket sends first round losers into a separate single
+	// elimination bracket so they still get games (single elimination only).
+	ConsolationBracket bool `json:"consolation_bracket"`
+
+	// BracketSizing decides how a field that is not a power of two is fitted into a
+	// single elimination bracket: byes (the default when empty) or play-ins.
+	BracketSizing string `json:"bracket_sizing,omitempty"`
+
+	// HeatSize is the number of participants per free-for-all heat and HeatAdvance
+	// how many of each heat's top finishers move on. HeatAdvance must divide HeatSize.
+	// Zero uses the bracket service defaults (4 and 2).
+	HeatSize    int `json:"heat_size,omitempty"`
+	HeatAdvance int `json:"heat_advance,omitempty"`
+
+	// TeamSize makes this a team tournament: every participant is a team registered
+	// with a roster of exactly TeamSize players. Zero is a solo tournament.
+	// MaxSubstitutes is how many substitutes a roster may carry on top of its players.
+	TeamSize       int `json:"team_size,omitempty"`
+	MaxSubstitutes int `json:"max_substitutes,omitempty"`
+
+	// SeparateTagsUntilRound keeps participants sharing a tag from meeting before this
+	// round of an elimination bracket, where swapping seeds within their tier allows.
+	// Zero does not separate tags.
+	SeparateTagsUntilRound int `json:"separate_tags_until_round,omitempty"`
+
+	// UnratedSeeding places unrated and provisional players when seeding by rating:
+	// last (the default when empty) or by rating.
+	UnratedSeeding string `json:"unrated_seeding,omitempty"`
+
+	// Tiebreakers orders participants tied on wins in round robin and Swiss standings.
+	// Empty uses the bracket service default for the format.
+	Tiebreakers []string `json:"tiebreakers,omitempty"`
+
+	// SwissRounds is the number of rounds in a Swiss event. Zero plays enough rounds
+	// to leave a single undefeated player.
+	SwissRounds int `json:"swiss_rounds,omitempty"`
+
+	// ScoringMode is how match results are reported: sets (the default when empty),
+	// a single score, or win/draw/loss. Round robin and Swiss matches can be drawn.
+	ScoringMode string `json:"scoring_mode,omitempty"`
+
+	// MatchRules is the set format matches are played under. Nil accepts any number
+	// of sets, won by whoever takes more of them. MatchRuleOverrides replace it for
+	// matches they apply to; the first override that applies wins.
+	MatchRules         *MatchRules         `json:"match_rules,omitempty"`
+	MatchRuleOverrides []MatchRuleOverride `json:"match_rule_overrides,omitempty"`
+
+	// Schedule projects a start time for every match from the tournament's start
+	// time. Nil leaves matches unscheduled.
+	Schedule *ScheduleSettings `json:"schedule,omitempty"`
+
+	// StreamSeeds features matches with a participant seeded this high or better:
+	// they are put on stream stations first. Zero uses the bracket service default (4).
+	StreamSeeds int `json:"stream_seeds,omitempty"`
+
+	// CheckInMinutes is how long both participants of a called match have to check in
+	// before a no-show forfeits. Zero uses the bracket service default (10 minutes).
+	CheckInMinutes int `json:"check_in_minutes,omitempty"`
+
+	// ReportConfirmMinutes is how long a participant has to confirm or dispute the
+	// result their opponent reported before it stands. Zero uses the bracket service
+	// default (15 minutes).
+	ReportConfirmMinutes int `json:"report_confirm_minutes,omitempty"`
+}
+
+// ScheduleSettings is how long matches take and how many can be played at once.
+type ScheduleSettings struct {
+	// MatchMinutes is the expected length of a match. Zero uses the bracket service
+	// default (30 minutes). BestOfMinutes replaces it for matches of a given best of.
+	MatchMinutes  int         `json:"match_minutes,omitempty"`
+	BestOfMinutes map[int]int `json:"best_of_minutes,omitempty"`
+
+	// Setups is the number of matches that can be played at the same time. Zero has
+	// no limit.
+	Setups int `json:"setups,omitempty"`
+
+	// RestMinutes is the least time a participant gets between two of their matches.
+	RestMinutes int `json:"rest_minutes,omitempty"`
+}
+
+// Validate checks that the schedule can be projected.
+func (s ScheduleSettings) Validate() error {
+	if s.MatchMinutes < 0 || s.Setups < 0 || s.RestMinutes < 0 {
+		return errors.New("match_minutes, setups and rest_minutes cannot be negative")
+	}
+	for bestOf, minutes := range s.BestOfMinutes {
+		if bestOf < 1 {
+			return errors.New("best_of_minutes: best of must be at least 1")
+		}
+		if minutes < 1 {
+			return fmt.Errorf("best_of_minutes: best of %d must take at least a minute", bestOf)
+		}
+	}
+	return nil
+}
+
+// MatchRules is a best-of-N set format.
+type MatchRules struct {
+	// BestOf is the most sets that can be played. Zero has no limit.
+	BestOf int `json:"best_of,omitempty"`
+
+	// SetsToWin is the number of sets that clinches the match. Zero defaults to a
+	// majority of BestOf.
+	SetsToWin int `json:"sets_to_win,omitempty"`
+
+	// AllowTiedSets accepts sets that end level; they count for neither side.
+	AllowTiedSets bool `json:"allow_tied_sets"`
+}
+
+// Validate checks that the rules can decide a match.
+func (r MatchRules) Validate() error {
+	if r.BestOf < 0 || r.SetsToWin < 0 {
+		return errors.New("best_of and sets_to_win cannot be negative")
+	}
+	if r.BestOf == 0 && r.SetsToWin == 0 {
+		return errors.New("match rules need best_of or sets_to_win")
+	}
+	if r.BestOf > 0 && r.SetsToWin > 0 && (r.SetsToWin > r.BestOf || r.SetsToWin*2 <= r.BestOf) {
+		return errors.New("sets_to_win must be more than half of best_of and no more than best_of")
+	}
+	return nil
+}
+
+// MatchRuleOverride applies its own match rules to one bracket type, one round, or one
+// round of one bracket type. Leave BracketType empty or Round zero to match any.
+type MatchRuleOverride struct {
+	BracketType string `json:"bracket_type,omitempty"`
+	Round       int    `json:"round,omitempty"`
+	MatchRules
+}
+
+// Bracket types match rules can be overridden for (heats are decided by placement)
+var overridableBracketTypes = map[string]bool{
+	"winners":     true,
+	"losers":      true,
+	"grand_final": true,
+	"round_robin": true,
+	"swiss":       true,
+	"third_place": true,
+	"consolation": true,
+}
+
+// Validate checks that the settings only use known options.
+func (s TournamentSettings) Validate() error {
+	seen := make(map[string]bool, len(s.Tiebreakers))
+	for _, t := range s.Tiebreakers {
+		switch t {
+		case TiebreakerHeadToHead, TiebreakerSetDifferential, TiebreakerSetsWon,
+			TiebreakerBuchholz, TiebreakerOpponentWinPercentage:
+		default:
+			return fmt.Errorf("unknown tiebreaker %q", t)
+		}
+		if seen[t] {
+			return fmt.Errorf("duplicate tiebreaker %q", t)
+		}
+		seen[t] = true
+	}
+	if s.SwissRounds < 0 {
+		return errors.New("swiss_rounds cannot be negative")
+	}
+	switch s.BracketSizing {
+	case "", SizingByes, SizingPlayIn:
+	default:
+		return fmt.Errorf("bracket_sizing must be '%s' or '%s'", SizingByes, SizingPlayIn)
+	}
+	if s.HeatSize < 0 || s.HeatAdvance < 0 {
+		return errors.New("heat_size and heat_advance cannot be negative")
+	}
+	if s.HeatSize > 0 || s.HeatAdvance > 0 {
+		size, advance := s.HeatSize, s.HeatAdvance
+		if size == 0 {
+			size = 4
+		}
+		if advance == 0 {
+			advance = 2
+		}
+		if size < 2 || advance >= size || size%advance != 0 {
+			return errors.New("heat_advance must be less than heat_size and divide it evenly")
+		}
+	}
+	if s.SeparateTagsUntilRound < 0 {
+		return errors.New("separate_tags_until_round cannot be negative")
+	}
+	switch s.UnratedSeeding {
+	case "", UnratedLast, UnratedRating:
+	default:
+		return fmt.Errorf("unrated_seeding must be '%s' or '%s'", UnratedLast, UnratedRating)
+	}
+	if s.TeamSize < 0 || s.MaxSubstitutes < 0 {
+		return errors.New("team_size and max_substitutes cannot be negative")
+	}
+	if s.TeamSize == 1 {
+		return errors.New("team_size must be at least 2 (0 for solo tournaments)")
+	}
+	if s.MaxSubstitutes > 0 && s.TeamSize == 0 {
+		return errors.New("max_substitutes requires team_size")
+	}
+	switch s.ScoringMode {
+	case "", ScoringSets, ScoringScore, ScoringWinDrawLoss:
+	default:
+		return fmt.Errorf("scoring_mode must be '%s', '%s' or '%s'", ScoringSets, ScoringScore, ScoringWinDrawLoss)
+	}
+	if (s.MatchRules != nil || len(s.MatchRuleOverrides) > 0) && s.ScoringMode != "" && s.ScoringMode != ScoringSets {
+		return errors.New("match rules only apply to set based scoring")
+	}
+	if s.MatchRules != nil {
+		if err := s.MatchRules.Validate(); err != nil {
+			return fmt.Errorf("match_rules: %w", err)
+		}
+	}
+	for i, o := range s.MatchRuleOverrides {
+		if o.BracketType != "" && !overridableBracketTypes[o.BracketType] {
+			return fmt.Errorf("match_rule_overrides[%d]: unknown bracket_type %q", i, o.BracketType)
+		}
+		if o.Round < 0 {
+			return fmt.Errorf("match_rule_overrides[%d]: round cannot be negative", i)
+		}
+		if o.BracketType == "" && o.Round == 0 {
+			return fmt.Errorf("match_rule_overrides[%d]: bracket_type or round is required", i)
+		}
+		if err := o.MatchRules.Validate(); err != nil {
+			return fmt.Errorf("match_rule_overrides[%d]: %w", i, err)
+		}
+	}
+	if s.StreamSeeds < 0 || s.CheckInMinutes < 0 || s.ReportConfirmMinutes < 0 {
+		return errors.New("stream_seeds, check_in_minutes and report_confirm_minutes cannot be negative")
+	}
+	if s.Schedule != nil {
+		if err := s.Schedule.Validate(); err != nil {
+			return fmt.Errorf("schedule: %w", err)
+		}
+	}
+	return nil
+}
+
+// IsTeamTournament reports whether participants register as teams with rosters.
+func (s TournamentSettings) IsTeamTournament() bool {
+	return s.TeamSize > 0
+}
+
+// ParseSettings decodes the settings column. Missing keys keep their zero values.
+func (t *Tournament) ParseSettings() (TournamentSettings, error) {
+	var settings TournamentSettings
+	if len(t.Settings) == 0 {
+		return settings, nil
+	}
+	err := json.Unmarshal(t.Settings, &settings)
+	return settings, err
+}
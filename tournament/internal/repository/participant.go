@@ -18,6 +18,8 @@ type ParticipantRepository interface {
 	CountByTournament(ctx context.Context, tournamentID uint64) (int, error)
 	UpdateSeeding(ctx context.Context, tournamentID uint64, seeds map[uint64]uint) error
 	UpdateStatus(ctx context.Context, id uint64, status domain.ParticipantStatus) error
+	UpdateTag(ctx context.Context, id uint64, tag *string) error
+	Substitute(ctx context.Context, replacedID uint64, sub *domain.Participant) error
 	Delete(ctx context.Context, id uint64) error
 }
 
@@ -31,12 +33,12 @@ func NewParticipantRepository(db *sql.DB) ParticipantRepository {
 
 func (r *participantRepository) Create(ctx context.Context, p *domain.Participant) error {
 	query := `
-		INSERT INTO participants (tournament_id, user_id, community_member_id, display_name, seed, status)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO participants (tournament_id, user_id, community_member_id, display_name, tag, seed, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id
 	`
 	err := r.db.QueryRowContext(ctx, query,
-		p.TournamentID, p.UserID, p.CommunityMemberID, p.DisplayName, p.Seed, p.Status,
+		p.TournamentID, p.UserID, p.CommunityMemberID, p.DisplayName, p.Tag, p.Seed, p.Status,
 	).Scan(&p.ID)
 	if err != nil {
 		return err
@@ -47,13 +49,13 @@ func (r *participantRepository) Create(ctx context.Context, p *domain.Participan
 
 func (r *participantRepository) GetByID(ctx context.Context, id uint64) (*domain.Participant, error) {
 	query := `
-		SELECT id, tournament_id, user_id, community_member_id, display_name, seed, status, checked_in_at, created_at
+		SELECT id, tournament_id, user_id, community_member_id, display_name, tag, seed, status, substitute_for_id, checked_in_at, created_at
 		FROM participants
 		WHERE id = $1
 	`
 	p := &domain.Participant{}
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&p.ID, &p.TournamentID, &p.UserID, &p.CommunityMemberID, &p.DisplayName, &p.Seed, &p.Status, &p.CheckedInAt, &p.CreatedAt,
+		&p.ID, &p.TournamentID, &p.UserID, &p.CommunityMemberID, &p.DisplayName, &p.Tag, &p.Seed, &p.Status, &p.SubstituteForID, &p.CheckedInAt, &p.CreatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -67,7 +69,7 @@ func (r *participantRepository) GetByID(ctx context.Context, id uint64) (*domain
 
 func (r *participantRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Participant, error) {
 	query := `
-		SELECT id, tournament_id, user_id, community_member_id, display_name, seed, status, checked_in_at, created_at
+		SELECT id, tournament_id, user_id, community_member_id, display_name, tag, seed, status, substitute_for_id, checked_in_at, created_at
 		FROM participants
 		WHERE tournament_id = $1
 		ORDER BY seed ASC NULLS LAST, created_at ASC
@@ -82,7 +84,7 @@ func (r *participantRepository) GetByTournament(ctx context.Context, tournamentI
 	for rows.Next() {
 		p := &domain.Participant{}
 		err := rows.Scan(
-			&p.ID, &p.TournamentID, &p.UserID, &p.CommunityMemberID, &p.DisplayName, &p.Seed, &p.Status, &p.CheckedInAt, &p.CreatedAt,
+			&p.ID, &p.TournamentID, &p.UserID, &p.CommunityMemberID, &p.DisplayName, &p.Tag, &p.Seed, &p.Status, &p.SubstituteForID, &p.CheckedInAt, &p.CreatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -99,13 +101,13 @@ func (r *participantRepository) GetByTournament(ctx context.Context, tournamentI
 
 func (r *participantRepository) GetByTournamentAndUser(ctx context.Context, tournamentID, userID uint64) (*domain.Participant, error) {
 	query := `
-		SELECT id, tournament_id, user_id, community_member_id, display_name, seed, status, checked_in_at, created_at
+		SELECT id, tournament_id, user_id, community_member_id, display_name, tag, seed, status, substitute_for_id, checked_in_at, created_at
 		FROM participants
 		WHERE tournament_id = $1 AND user_id = $2
 	`
 	p := &domain.Participant{}
 	err := r.db.QueryRowContext(ctx, query, tournamentID, userID).Scan(
-		&p.ID, &p.TournamentID, &p.UserID, &p.CommunityMemberID, &p.DisplayName, &p.Seed, &p.Status, &p.CheckedInAt, &p.CreatedAt,
+		&p.ID, &p.TournamentID, &p.UserID, &p.CommunityMemberID, &p.DisplayName, &p.Tag, &p.Seed, &p.Status, &p.SubstituteForID, &p.CheckedInAt, &p.CreatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -169,6 +171,58 @@ func (r *participantRepository) UpdateStatus(ctx context.Context, id uint64, sta
 	return nil
 }
 
+// Substitute creates the substitute participant, recording who they replace, and marks
+// the replaced participant as substituted, in one transaction.
+func (r *participantRepository) Substitute(ctx context.Context, replacedID uint64, sub *domain.Participant) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE participants SET status = $1 WHERE id = $2`, domain.ParticipantSubstituted, replacedID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrParticipantNotFound
+	}
+
+	sub.SubstituteForID = &replacedID
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO participants (tournament_id, user_id, community_member_id, display_name, tag, seed, status, substitute_for_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`, sub.TournamentID, sub.UserID, sub.CommunityMemberID, sub.DisplayName, sub.Tag, sub.Seed, sub.Status, sub.SubstituteForID).Scan(&sub.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *participantRepository) UpdateTag(ctx context.Context, id uint64, tag *string) error {
+	query := `UPDATE participants SET tag = $1 WHERE id = $2`
+	result, err := r.db.ExecContext(ctx, query, tag, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrParticipantNotFound
+	}
+
+	return nil
+}
+
 func (r *participantRepository) Delete(ctx context.Context, id uint64) error {
 	query := `DELETE FROM participants WHERE id = $1`
 	result, err := r.db.ExecContext(ctx, query, id)
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/braccet/tournament/internal/domain"
+)
+
+type StageRepository interface {
+	GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Stage, error)
+	ReplaceForTournament(ctx context.Context, tournamentID uint64, stages []domain.Stage) error
+}
+
+type stageRepository struct {
+	db *sql.DB
+}
+
+func NewStageRepository(db *sql.DB) StageRepository {
+	return &stageRepository{db: db}
+}
+
+func (r *stageRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Stage, error) {
+	query := `
+		SELECT id, tournament_id, stage_number, format::text, pool_count, qualifiers_per_pool, created_at
+		FROM tournament_stages
+		WHERE tournament_id = $1
+		ORDER BY stage_number
+	`
+	rows, err := r.db.QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stages []*domain.Stage
+	for rows.Next() {
+		s := &domain.Stage{}
+		if err := rows.Scan(
+			&s.ID, &s.TournamentID, &s.StageNumber, &s.Format, &s.PoolCount, &s.QualifiersPerPool, &s.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		stages = append(stages, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stages, nil
+}
+
+// ReplaceForTournament replaces a tournament's stages with the given list, numbering
+// them in order starting at 1.
+func (r *stageRepository) ReplaceForTournament(ctx context.Context, tournamentID uint64, stages []domain.Stage) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM tournament_stages WHERE tournament_id = $1`, tournamentID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO tournament_stages (tournament_id, stage_number, format, pool_count, qualifiers_per_pool)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	for i, s := range stages {
+		if _, err := tx.ExecContext(ctx, query, tournamentID, i+1, s.Format, s.PoolCount, s.QualifiersPerPool); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/braccet/tournament/internal/domain"
+	"github.com/lib/pq"
+)
+
+var ErrTeamNotFound = errors.New("team not found")
+
+type TeamRepository interface {
+	Create(ctx context.Context, p *domain.Participant, team *domain.Team) error
+	GetByParticipantID(ctx context.Context, participantID uint64) (*domain.Team, error)
+	GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Team, error)
+	GetByTournamentAndUser(ctx context.Context, tournamentID, userID uint64) (*domain.Team, error)
+	UpdateRoster(ctx context.Context, team *domain.Team) error
+}
+
+type teamRepository struct {
+	db *sql.DB
+}
+
+func NewTeamRepository(db *sql.DB) TeamRepository {
+	return &teamRepository{db: db}
+}
+
+// Create registers a team: it creates the participant the team competes as, then the
+// team and its roster, in one transaction.
+func (r *teamRepository) Create(ctx context.Context, p *domain.Participant, team *domain.Team) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO participants (tournament_id, user_id, community_member_id, display_name, tag, seed, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, p.TournamentID, p.UserID, p.CommunityMemberID, p.DisplayName, p.Tag, p.Seed, p.Status).Scan(&p.ID)
+	if err != nil {
+		return err
+	}
+
+	team.TournamentID = p.TournamentID
+	team.ParticipantID = p.ID
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO teams (tournament_id, participant_id, captain_user_id)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`, team.TournamentID, team.ParticipantID, team.CaptainUserID).Scan(&team.ID, &team.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := insertMembers(ctx, tx, team); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *teamRepository) GetByParticipantID(ctx context.Context, participantID uint64) (*domain.Team, error) {
+	query := `
+		SELECT id, tournament_id, participant_id, captain_user_id, created_at
+		FROM teams
+		WHERE participant_id = $1
+	`
+	t := &domain.Team{}
+	err := r.db.QueryRowContext(ctx, query, participantID).Scan(
+		&t.ID, &t.TournamentID, &t.ParticipantID, &t.CaptainUserID, &t.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadMembers(ctx, []*domain.Team{t}); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (r *teamRepository) GetByTournament(ctx context.Context, tournamentID uint64) ([]*domain.Team, error) {
+	query := `
+		SELECT id, tournament_id, participant_id, captain_user_id, created_at
+		FROM teams
+		WHERE tournament_id = $1
+		ORDER BY id
+	`
+	rows, err := r.db.QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*domain.Team
+	for rows.Next() {
+		t := &domain.Team{}
+		if err := rows.Scan(&t.ID, &t.TournamentID, &t.ParticipantID, &t.CaptainUserID, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadMembers(ctx, teams); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// GetByTournamentAndUser returns the team whose roster includes the user.
+func (r *teamRepository) GetByTournamentAndUser(ctx context.Context, tournamentID, userID uint64) (*domain.Team, error) {
+	query := `
+		SELECT t.id, t.tournament_id, t.participant_id, t.captain_user_id, t.created_at
+		FROM teams t
+		JOIN team_members tm ON tm.team_id = t.id
+		WHERE t.tournament_id = $1 AND tm.user_id = $2
+		LIMIT 1
+	`
+	t := &domain.Team{}
+	err := r.db.QueryRowContext(ctx, query, tournamentID, userID).Scan(
+		&t.ID, &t.TournamentID, &t.ParticipantID, &t.CaptainUserID, &t.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadMembers(ctx, []*domain.Team{t}); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// UpdateRoster replaces the team's captain and roster.
+func (r *teamRepository) UpdateRoster(ctx context.Context, team *domain.Team) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE teams SET captain_user_id = $1 WHERE id = $2`, team.CaptainUserID, team.ID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrTeamNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM team_members WHERE team_id = $1`, team.ID); err != nil {
+		return err
+	}
+	if err := insertMembers(ctx, tx, team); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertMembers(ctx context.Context, tx *sql.Tx, team *domain.Team) error {
+	query := `
+		INSERT INTO team_members (team_id, user_id, community_member_id, display_name, is_substitute)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	for i := range team.Members {
+		m := &team.Members[i]
+		m.TeamID = team.ID
+		err := tx.QueryRowContext(ctx, query,
+			m.TeamID, m.UserID, m.CommunityMemberID, m.DisplayName, m.Substitute,
+		).Scan(&m.ID, &m.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadMembers fills in the rosters of the given teams, players before substitutes.
+func (r *teamRepository) loadMembers(ctx context.Context, teams []*domain.Team) error {
+	if len(teams) == 0 {
+		return nil
+	}
+
+	byID := make(map[uint64]*domain.Team, len(teams))
+	ids := make([]int64, len(teams))
+	for i, t := range teams {
+		byID[t.ID] = t
+		ids[i] = int64(t.ID)
+	}
+
+	query := `
+		SELECT id, team_id, user_id, community_member_id, display_name, is_substitute, created_at
+		FROM team_members
+		WHERE team_id = ANY($1)
+		ORDER BY team_id, is_substitute, id
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var m domain.TeamMember
+		if err := rows.Scan(
+			&m.ID, &m.TeamID, &m.UserID, &m.CommunityMemberID, &m.DisplayName, &m.Substitute, &m.CreatedAt,
+		); err != nil {
+			return err
+		}
+		byID[m.TeamID].Members = append(byID[m.TeamID].Members, m)
+	}
+
+	return rows.Err()
+}
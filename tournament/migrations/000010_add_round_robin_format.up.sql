@@ -0,0 +1,4 @@
+-- Add 'round_robin' to tournament_format enum
+-- Used for leagues where every participant plays every other participant once
+
+ALTER TYPE tournament_format ADD VALUE 'round_robin';
@@ -0,0 +1,4 @@
+-- Add 'swiss' to tournament_format enum
+-- Used for events paired round by round from the results so far
+
+ALTER TYPE tournament_format ADD VALUE 'swiss';
@@ -0,0 +1,4 @@
+-- Add 'multi_stage' to tournament_format enum
+-- Multi-stage tournaments take their formats from tournament_stages
+
+ALTER TYPE tournament_format ADD VALUE 'multi_stage';
@@ -0,0 +1,14 @@
+-- Ordered stages of a multi-stage tournament (e.g. round robin pools into a playoff bracket)
+
+CREATE TABLE tournament_stages (
+    id BIGSERIAL PRIMARY KEY,
+    tournament_id BIGINT NOT NULL,
+    stage_number INT NOT NULL,
+    format tournament_format NOT NULL,
+    pool_count INT NOT NULL DEFAULT 1,
+    qualifiers_per_pool INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (tournament_id) REFERENCES tournaments(id) ON DELETE CASCADE,
+    UNIQUE (tournament_id, stage_number)
+);
@@ -0,0 +1,4 @@
+-- Add 'free_for_all' to tournament_format enum
+-- Free-for-all tournaments are played in heats of more than two participants
+
+ALTER TYPE tournament_format ADD VALUE 'free_for_all';
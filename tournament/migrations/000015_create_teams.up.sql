@@ -0,0 +1,30 @@
+-- Teams compete as a single participant; team_members is the roster behind it
+-- Note: user_id and community_member_id reference other services, validated via API call (no FK)
+
+CREATE TABLE teams (
+    id BIGSERIAL PRIMARY KEY,
+    tournament_id BIGINT NOT NULL,
+    participant_id BIGINT NOT NULL,
+    captain_user_id BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (tournament_id) REFERENCES tournaments(id) ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants(id) ON DELETE CASCADE,
+    UNIQUE (participant_id)
+);
+
+CREATE TABLE team_members (
+    id BIGSERIAL PRIMARY KEY,
+    team_id BIGINT NOT NULL,
+    user_id BIGINT,
+    community_member_id BIGINT,
+    display_name VARCHAR(100) NOT NULL,
+    is_substitute BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_teams_tournament ON teams(tournament_id);
+CREATE INDEX idx_team_members_team ON team_members(team_id);
+CREATE INDEX idx_team_members_user ON team_members(user_id);
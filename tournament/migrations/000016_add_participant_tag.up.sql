@@ -0,0 +1,2 @@
+-- Region, club, previous pool, etc. Brackets can keep participants sharing a tag apart early on
+ALTER TABLE participants ADD COLUMN tag VARCHAR(50);
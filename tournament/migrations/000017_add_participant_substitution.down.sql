@@ -0,0 +1,4 @@
+ALTER TABLE participants DROP COLUMN substitute_for_id;
+
+-- PostgreSQL cannot easily remove enum values, so 'substituted' stays in
+-- participant_status (see 000006_add_withdrawn_status.down.sql)
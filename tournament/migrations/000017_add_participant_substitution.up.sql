@@ -0,0 +1,6 @@
+-- Substitutions in a running bracket: the substitute is a new participant who takes
+-- over the bracket position of the participant they replace, who is marked 'substituted'
+
+ALTER TYPE participant_status ADD VALUE 'substituted';
+
+ALTER TABLE participants ADD COLUMN substitute_for_id BIGINT REFERENCES participants(id) ON DELETE SET NULL;